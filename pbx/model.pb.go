@@ -50,33 +50,43 @@ func (x AuthLevel) String() string {
 	return proto.EnumName(AuthLevel_name, int32(x))
 }
 func (AuthLevel) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{0}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{0}
 }
 
 type InfoNote int32
 
 const (
-	InfoNote_READ InfoNote = 0
-	InfoNote_RECV InfoNote = 1
-	InfoNote_KP   InfoNote = 2
+	InfoNote_READ   InfoNote = 0
+	InfoNote_RECV   InfoNote = 1
+	InfoNote_KP     InfoNote = 2
+	InfoNote_CTREAD InfoNote = 3
+	// Emoji reaction to the message added or removed
+	InfoNote_REACT   InfoNote = 4
+	InfoNote_UNREACT InfoNote = 5
 )
 
 var InfoNote_name = map[int32]string{
 	0: "READ",
 	1: "RECV",
 	2: "KP",
+	3: "CTREAD",
+	4: "REACT",
+	5: "UNREACT",
 }
 var InfoNote_value = map[string]int32{
-	"READ": 0,
-	"RECV": 1,
-	"KP":   2,
+	"READ":    0,
+	"RECV":    1,
+	"KP":      2,
+	"CTREAD":  3,
+	"REACT":   4,
+	"UNREACT": 5,
 }
 
 func (x InfoNote) String() string {
 	return proto.EnumName(InfoNote_name, int32(x))
 }
 func (InfoNote) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{1}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{1}
 }
 
 // Plugin response codes
@@ -111,7 +121,7 @@ func (x RespCode) String() string {
 	return proto.EnumName(RespCode_name, int32(x))
 }
 func (RespCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{2}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{2}
 }
 
 type Crud int32
@@ -137,7 +147,33 @@ func (x Crud) String() string {
 	return proto.EnumName(Crud_name, int32(x))
 }
 func (Crud) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{3}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{3}
+}
+
+type SetContact_Star int32
+
+const (
+	SetContact_UNCHANGED SetContact_Star = 0
+	SetContact_STAR      SetContact_Star = 1
+	SetContact_UNSTAR    SetContact_Star = 2
+)
+
+var SetContact_Star_name = map[int32]string{
+	0: "UNCHANGED",
+	1: "STAR",
+	2: "UNSTAR",
+}
+var SetContact_Star_value = map[string]int32{
+	"UNCHANGED": 0,
+	"STAR":      1,
+	"UNSTAR":    2,
+}
+
+func (x SetContact_Star) String() string {
+	return proto.EnumName(SetContact_Star_name, int32(x))
+}
+func (SetContact_Star) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{8, 0}
 }
 
 // What to delete, either "msg" to delete messages (default) or "topic" to delete the topic or "sub"
@@ -145,10 +181,14 @@ func (Crud) EnumDescriptor() ([]byte, []int) {
 type ClientDel_What int32
 
 const (
-	ClientDel_MSG   ClientDel_What = 0
-	ClientDel_TOPIC ClientDel_What = 1
-	ClientDel_SUB   ClientDel_What = 2
-	ClientDel_USER  ClientDel_What = 3
+	ClientDel_MSG     ClientDel_What = 0
+	ClientDel_TOPIC   ClientDel_What = 1
+	ClientDel_SUB     ClientDel_What = 2
+	ClientDel_USER    ClientDel_What = 3
+	ClientDel_CTMSG   ClientDel_What = 4
+	ClientDel_CONTACT ClientDel_What = 5
+	// Recall the sender's own message for everyone
+	ClientDel_RECALL ClientDel_What = 6
 )
 
 var ClientDel_What_name = map[int32]string{
@@ -156,35 +196,48 @@ var ClientDel_What_name = map[int32]string{
 	1: "TOPIC",
 	2: "SUB",
 	3: "USER",
+	4: "CTMSG",
+	5: "CONTACT",
+	6: "RECALL",
 }
 var ClientDel_What_value = map[string]int32{
-	"MSG":   0,
-	"TOPIC": 1,
-	"SUB":   2,
-	"USER":  3,
+	"MSG":     0,
+	"TOPIC":   1,
+	"SUB":     2,
+	"USER":    3,
+	"CTMSG":   4,
+	"CONTACT": 5,
+	"RECALL":  6,
 }
 
 func (x ClientDel_What) String() string {
 	return proto.EnumName(ClientDel_What_name, int32(x))
 }
 func (ClientDel_What) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{18, 0}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{21, 0}
 }
 
 type ServerPres_What int32
 
 const (
-	ServerPres_ON   ServerPres_What = 0
-	ServerPres_OFF  ServerPres_What = 1
-	ServerPres_UA   ServerPres_What = 3
-	ServerPres_UPD  ServerPres_What = 4
-	ServerPres_GONE ServerPres_What = 5
-	ServerPres_ACS  ServerPres_What = 6
-	ServerPres_TERM ServerPres_What = 7
-	ServerPres_MSG  ServerPres_What = 8
-	ServerPres_READ ServerPres_What = 9
-	ServerPres_RECV ServerPres_What = 10
-	ServerPres_DEL  ServerPres_What = 11
+	ServerPres_ON       ServerPres_What = 0
+	ServerPres_OFF      ServerPres_What = 1
+	ServerPres_UA       ServerPres_What = 3
+	ServerPres_UPD      ServerPres_What = 4
+	ServerPres_GONE     ServerPres_What = 5
+	ServerPres_ACS      ServerPres_What = 6
+	ServerPres_TERM     ServerPres_What = 7
+	ServerPres_MSG      ServerPres_What = 8
+	ServerPres_READ     ServerPres_What = 9
+	ServerPres_RECV     ServerPres_What = 10
+	ServerPres_DEL      ServerPres_What = 11
+	ServerPres_CTADD    ServerPres_What = 12
+	ServerPres_CTREJECT ServerPres_What = 13
+	ServerPres_CTAGREE  ServerPres_What = 14
+	ServerPres_CTMDEL   ServerPres_What = 15
+	ServerPres_SIGNAL   ServerPres_What = 16
+	ServerPres_CTDEL    ServerPres_What = 17
+	ServerPres_CTUPD    ServerPres_What = 18
 )
 
 var ServerPres_What_name = map[int32]string{
@@ -199,26 +252,40 @@ var ServerPres_What_name = map[int32]string{
 	9:  "READ",
 	10: "RECV",
 	11: "DEL",
+	12: "CTADD",
+	13: "CTREJECT",
+	14: "CTAGREE",
+	15: "CTMDEL",
+	16: "SIGNAL",
+	17: "CTDEL",
+	18: "CTUPD",
 }
 var ServerPres_What_value = map[string]int32{
-	"ON":   0,
-	"OFF":  1,
-	"UA":   3,
-	"UPD":  4,
-	"GONE": 5,
-	"ACS":  6,
-	"TERM": 7,
-	"MSG":  8,
-	"READ": 9,
-	"RECV": 10,
-	"DEL":  11,
+	"ON":       0,
+	"OFF":      1,
+	"UA":       3,
+	"UPD":      4,
+	"GONE":     5,
+	"ACS":      6,
+	"TERM":     7,
+	"MSG":      8,
+	"READ":     9,
+	"RECV":     10,
+	"DEL":      11,
+	"CTADD":    12,
+	"CTREJECT": 13,
+	"CTAGREE":  14,
+	"CTMDEL":   15,
+	"SIGNAL":   16,
+	"CTDEL":    17,
+	"CTUPD":    18,
 }
 
 func (x ServerPres_What) String() string {
 	return proto.EnumName(ServerPres_What_name, int32(x))
 }
 func (ServerPres_What) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{26, 0}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{33, 0}
 }
 
 // Dummy placeholder message.
@@ -232,7 +299,7 @@ func (m *Unused) Reset()         { *m = Unused{} }
 func (m *Unused) String() string { return proto.CompactTextString(m) }
 func (*Unused) ProtoMessage()    {}
 func (*Unused) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{0}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{0}
 }
 func (m *Unused) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Unused.Unmarshal(m, b)
@@ -265,7 +332,7 @@ func (m *DefaultAcsMode) Reset()         { *m = DefaultAcsMode{} }
 func (m *DefaultAcsMode) String() string { return proto.CompactTextString(m) }
 func (*DefaultAcsMode) ProtoMessage()    {}
 func (*DefaultAcsMode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{1}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{1}
 }
 func (m *DefaultAcsMode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DefaultAcsMode.Unmarshal(m, b)
@@ -314,7 +381,7 @@ func (m *AccessMode) Reset()         { *m = AccessMode{} }
 func (m *AccessMode) String() string { return proto.CompactTextString(m) }
 func (*AccessMode) ProtoMessage()    {}
 func (*AccessMode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{2}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{2}
 }
 func (m *AccessMode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccessMode.Unmarshal(m, b)
@@ -363,7 +430,7 @@ func (m *SetSub) Reset()         { *m = SetSub{} }
 func (m *SetSub) String() string { return proto.CompactTextString(m) }
 func (*SetSub) ProtoMessage()    {}
 func (*SetSub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{3}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{3}
 }
 func (m *SetSub) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetSub.Unmarshal(m, b)
@@ -411,7 +478,7 @@ func (m *SetDesc) Reset()         { *m = SetDesc{} }
 func (m *SetDesc) String() string { return proto.CompactTextString(m) }
 func (*SetDesc) ProtoMessage()    {}
 func (*SetDesc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{4}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{4}
 }
 func (m *SetDesc) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetDesc.Unmarshal(m, b)
@@ -464,7 +531,15 @@ type GetOpts struct {
 	// Load messages with seq id lower than this
 	BeforeId int32 `protobuf:"varint,5,opt,name=before_id,json=beforeId" json:"before_id,omitempty"`
 	// Maximum number of results to return
-	Limit                int32    `protobuf:"varint,6,opt,name=limit" json:"limit,omitempty"`
+	Limit int32 `protobuf:"varint,6,opt,name=limit" json:"limit,omitempty"`
+	// Return contacts with this label only
+	Label string `protobuf:"bytes,7,opt,name=label" json:"label,omitempty"`
+	// Load replies to the message with this seq id only
+	Thread int32 `protobuf:"varint,8,opt,name=thread" json:"thread,omitempty"`
+	// Full-text search query
+	Query string `protobuf:"bytes,9,opt,name=query" json:"query,omitempty"`
+	// Search only messages already read by the user
+	Read                 bool     `protobuf:"varint,10,opt,name=read" json:"read,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -474,7 +549,7 @@ func (m *GetOpts) Reset()         { *m = GetOpts{} }
 func (m *GetOpts) String() string { return proto.CompactTextString(m) }
 func (*GetOpts) ProtoMessage()    {}
 func (*GetOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{5}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{5}
 }
 func (m *GetOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetOpts.Unmarshal(m, b)
@@ -536,6 +611,34 @@ func (m *GetOpts) GetLimit() int32 {
 	return 0
 }
 
+func (m *GetOpts) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *GetOpts) GetThread() int32 {
+	if m != nil {
+		return m.Thread
+	}
+	return 0
+}
+
+func (m *GetOpts) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *GetOpts) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
 type GetQuery struct {
 	What string `protobuf:"bytes,1,opt,name=what" json:"what,omitempty"`
 	// Parameters of "desc" request
@@ -543,7 +646,13 @@ type GetQuery struct {
 	// Parameters of "sub" request
 	Sub *GetOpts `protobuf:"bytes,3,opt,name=sub" json:"sub,omitempty"`
 	// Parameters of "data" request
-	Data                 *GetOpts `protobuf:"bytes,4,opt,name=data" json:"data,omitempty"`
+	Data *GetOpts `protobuf:"bytes,4,opt,name=data" json:"data,omitempty"`
+	// Parameters of "ctmsg" request
+	Ctmsg *GetOpts `protobuf:"bytes,5,opt,name=ctmsg" json:"ctmsg,omitempty"`
+	// Parameters of "contact" request
+	Contact *GetOpts `protobuf:"bytes,6,opt,name=contact" json:"contact,omitempty"`
+	// Parameters of "search" request
+	Search               *GetOpts `protobuf:"bytes,7,opt,name=search" json:"search,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -553,7 +662,7 @@ func (m *GetQuery) Reset()         { *m = GetQuery{} }
 func (m *GetQuery) String() string { return proto.CompactTextString(m) }
 func (*GetQuery) ProtoMessage()    {}
 func (*GetQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{6}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{6}
 }
 func (m *GetQuery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetQuery.Unmarshal(m, b)
@@ -601,23 +710,50 @@ func (m *GetQuery) GetData() *GetOpts {
 	return nil
 }
 
+func (m *GetQuery) GetCtmsg() *GetOpts {
+	if m != nil {
+		return m.Ctmsg
+	}
+	return nil
+}
+
+func (m *GetQuery) GetContact() *GetOpts {
+	if m != nil {
+		return m.Contact
+	}
+	return nil
+}
+
+func (m *GetQuery) GetSearch() *GetOpts {
+	if m != nil {
+		return m.Search
+	}
+	return nil
+}
+
 type SetQuery struct {
 	// Topic metadata, new topic & new subscriptions only
 	Desc *SetDesc `protobuf:"bytes,1,opt,name=desc" json:"desc,omitempty"`
 	// Subscription parameters
 	Sub *SetSub `protobuf:"bytes,2,opt,name=sub" json:"sub,omitempty"`
 	// Indexable tags
-	Tags                 []string `protobuf:"bytes,3,rep,name=tags" json:"tags,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Tags []string `protobuf:"bytes,3,rep,name=tags" json:"tags,omitempty"`
+	// Changes to the block list, 'me' topic only
+	Block *SetBlock `protobuf:"bytes,4,opt,name=block" json:"block,omitempty"`
+	// Privacy settings, 'me' topic only
+	Privacy *Privacy `protobuf:"bytes,5,opt,name=privacy" json:"privacy,omitempty"`
+	// Metadata of a contact, 'me' topic only
+	Contact              *SetContact `protobuf:"bytes,6,opt,name=contact" json:"contact,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *SetQuery) Reset()         { *m = SetQuery{} }
 func (m *SetQuery) String() string { return proto.CompactTextString(m) }
 func (*SetQuery) ProtoMessage()    {}
 func (*SetQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{7}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{7}
 }
 func (m *SetQuery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetQuery.Unmarshal(m, b)
@@ -658,6 +794,196 @@ func (m *SetQuery) GetTags() []string {
 	return nil
 }
 
+func (m *SetQuery) GetBlock() *SetBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *SetQuery) GetPrivacy() *Privacy {
+	if m != nil {
+		return m.Privacy
+	}
+	return nil
+}
+
+func (m *SetQuery) GetContact() *SetContact {
+	if m != nil {
+		return m.Contact
+	}
+	return nil
+}
+
+type SetContact struct {
+	// User ID of the contact
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+	// Private alias of the contact, Unicode Del character '\u2421' to clear
+	Remark string `protobuf:"bytes,2,opt,name=remark" json:"remark,omitempty"`
+	// Custom groups of the contact, a single Del string to clear
+	Labels               []string        `protobuf:"bytes,3,rep,name=labels" json:"labels,omitempty"`
+	Starred              SetContact_Star `protobuf:"varint,4,opt,name=starred,enum=pbx.SetContact_Star" json:"starred,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SetContact) Reset()         { *m = SetContact{} }
+func (m *SetContact) String() string { return proto.CompactTextString(m) }
+func (*SetContact) ProtoMessage()    {}
+func (*SetContact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{8}
+}
+func (m *SetContact) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetContact.Unmarshal(m, b)
+}
+func (m *SetContact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetContact.Marshal(b, m, deterministic)
+}
+func (dst *SetContact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetContact.Merge(dst, src)
+}
+func (m *SetContact) XXX_Size() int {
+	return xxx_messageInfo_SetContact.Size(m)
+}
+func (m *SetContact) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetContact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetContact proto.InternalMessageInfo
+
+func (m *SetContact) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *SetContact) GetRemark() string {
+	if m != nil {
+		return m.Remark
+	}
+	return ""
+}
+
+func (m *SetContact) GetLabels() []string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *SetContact) GetStarred() SetContact_Star {
+	if m != nil {
+		return m.Starred
+	}
+	return SetContact_UNCHANGED
+}
+
+type SetBlock struct {
+	// User IDs to block
+	Add []string `protobuf:"bytes,1,rep,name=add" json:"add,omitempty"`
+	// User IDs to unblock
+	Rem                  []string `protobuf:"bytes,2,rep,name=rem" json:"rem,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBlock) Reset()         { *m = SetBlock{} }
+func (m *SetBlock) String() string { return proto.CompactTextString(m) }
+func (*SetBlock) ProtoMessage()    {}
+func (*SetBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{9}
+}
+func (m *SetBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBlock.Unmarshal(m, b)
+}
+func (m *SetBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBlock.Marshal(b, m, deterministic)
+}
+func (dst *SetBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBlock.Merge(dst, src)
+}
+func (m *SetBlock) XXX_Size() int {
+	return xxx_messageInfo_SetBlock.Size(m)
+}
+func (m *SetBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBlock proto.InternalMessageInfo
+
+func (m *SetBlock) GetAdd() []string {
+	if m != nil {
+		return m.Add
+	}
+	return nil
+}
+
+func (m *SetBlock) GetRem() []string {
+	if m != nil {
+		return m.Rem
+	}
+	return nil
+}
+
+type Privacy struct {
+	// Who may send contact requests: "all", "fof", "none" or "verify"
+	ContactRequests string `protobuf:"bytes,1,opt,name=contact_requests,json=contactRequests" json:"contact_requests,omitempty"`
+	// Verification question and answer for "verify"
+	Question             string   `protobuf:"bytes,2,opt,name=question" json:"question,omitempty"`
+	Answer               string   `protobuf:"bytes,3,opt,name=answer" json:"answer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Privacy) Reset()         { *m = Privacy{} }
+func (m *Privacy) String() string { return proto.CompactTextString(m) }
+func (*Privacy) ProtoMessage()    {}
+func (*Privacy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{10}
+}
+func (m *Privacy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Privacy.Unmarshal(m, b)
+}
+func (m *Privacy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Privacy.Marshal(b, m, deterministic)
+}
+func (dst *Privacy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Privacy.Merge(dst, src)
+}
+func (m *Privacy) XXX_Size() int {
+	return xxx_messageInfo_Privacy.Size(m)
+}
+func (m *Privacy) XXX_DiscardUnknown() {
+	xxx_messageInfo_Privacy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Privacy proto.InternalMessageInfo
+
+func (m *Privacy) GetContactRequests() string {
+	if m != nil {
+		return m.ContactRequests
+	}
+	return ""
+}
+
+func (m *Privacy) GetQuestion() string {
+	if m != nil {
+		return m.Question
+	}
+	return ""
+}
+
+func (m *Privacy) GetAnswer() string {
+	if m != nil {
+		return m.Answer
+	}
+	return ""
+}
+
 type SeqRange struct {
 	Low                  int32    `protobuf:"varint,1,opt,name=low" json:"low,omitempty"`
 	Hi                   int32    `protobuf:"varint,2,opt,name=hi" json:"hi,omitempty"`
@@ -670,7 +996,7 @@ func (m *SeqRange) Reset()         { *m = SeqRange{} }
 func (m *SeqRange) String() string { return proto.CompactTextString(m) }
 func (*SeqRange) ProtoMessage()    {}
 func (*SeqRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{8}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{11}
 }
 func (m *SeqRange) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SeqRange.Unmarshal(m, b)
@@ -722,7 +1048,7 @@ func (m *Credential) Reset()         { *m = Credential{} }
 func (m *Credential) String() string { return proto.CompactTextString(m) }
 func (*Credential) ProtoMessage()    {}
 func (*Credential) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{9}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{12}
 }
 func (m *Credential) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Credential.Unmarshal(m, b)
@@ -787,7 +1113,7 @@ func (m *ClientHi) Reset()         { *m = ClientHi{} }
 func (m *ClientHi) String() string { return proto.CompactTextString(m) }
 func (*ClientHi) ProtoMessage()    {}
 func (*ClientHi) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{10}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{13}
 }
 func (m *ClientHi) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClientHi.Unmarshal(m, b)
@@ -877,7 +1203,7 @@ func (m *ClientAcc) Reset()         { *m = ClientAcc{} }
 func (m *ClientAcc) String() string { return proto.CompactTextString(m) }
 func (*ClientAcc) ProtoMessage()    {}
 func (*ClientAcc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{11}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{14}
 }
 func (m *ClientAcc) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClientAcc.Unmarshal(m, b)
@@ -978,7 +1304,7 @@ func (m *ClientLogin) Reset()         { *m = ClientLogin{} }
 func (m *ClientLogin) String() string { return proto.CompactTextString(m) }
 func (*ClientLogin) ProtoMessage()    {}
 func (*ClientLogin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{12}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{15}
 }
 func (m *ClientLogin) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClientLogin.Unmarshal(m, b)
@@ -1043,7 +1369,7 @@ func (m *ClientSub) Reset()         { *m = ClientSub{} }
 func (m *ClientSub) String() string { return proto.CompactTextString(m) }
 func (*ClientSub) ProtoMessage()    {}
 func (*ClientSub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{13}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{16}
 }
 func (m *ClientSub) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClientSub.Unmarshal(m, b)
@@ -1105,7 +1431,7 @@ func (m *ClientLeave) Reset()         { *m = ClientLeave{} }
 func (m *ClientLeave) String() string { return proto.CompactTextString(m) }
 func (*ClientLeave) ProtoMessage()    {}
 func (*ClientLeave) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{14}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{17}
 }
 func (m *ClientLeave) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClientLeave.Unmarshal(m, b)
@@ -1148,21 +1474,25 @@ func (m *ClientLeave) GetUnsub() bool {
 
 // ClientPub is client's request to publish data to topic subscribers {pub}
 type ClientPub struct {
-	Id                   string            `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Topic                string            `protobuf:"bytes,2,opt,name=topic" json:"topic,omitempty"`
-	NoEcho               bool              `protobuf:"varint,3,opt,name=no_echo,json=noEcho" json:"no_echo,omitempty"`
-	Head                 map[string][]byte `protobuf:"bytes,4,rep,name=head" json:"head,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Content              []byte            `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Id      string            `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Topic   string            `protobuf:"bytes,2,opt,name=topic" json:"topic,omitempty"`
+	NoEcho  bool              `protobuf:"varint,3,opt,name=no_echo,json=noEcho" json:"no_echo,omitempty"`
+	Head    map[string][]byte `protobuf:"bytes,4,rep,name=head" json:"head,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Content []byte            `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	// Seq ID of the sender's own message to replace with the new content
+	Replace int32 `protobuf:"varint,6,opt,name=replace" json:"replace,omitempty"`
+	// Seq ID of the message this message replies to
+	ReplyTo              int32    `protobuf:"varint,7,opt,name=reply_to,json=replyTo" json:"reply_to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ClientPub) Reset()         { *m = ClientPub{} }
 func (m *ClientPub) String() string { return proto.CompactTextString(m) }
 func (*ClientPub) ProtoMessage()    {}
 func (*ClientPub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{15}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{18}
 }
 func (m *ClientPub) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClientPub.Unmarshal(m, b)
@@ -1217,6 +1547,20 @@ func (m *ClientPub) GetContent() []byte {
 	return nil
 }
 
+func (m *ClientPub) GetReplace() int32 {
+	if m != nil {
+		return m.Replace
+	}
+	return 0
+}
+
+func (m *ClientPub) GetReplyTo() int32 {
+	if m != nil {
+		return m.ReplyTo
+	}
+	return 0
+}
+
 // Query topic state {get}
 type ClientGet struct {
 	Id                   string    `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
@@ -1231,7 +1575,7 @@ func (m *ClientGet) Reset()         { *m = ClientGet{} }
 func (m *ClientGet) String() string { return proto.CompactTextString(m) }
 func (*ClientGet) ProtoMessage()    {}
 func (*ClientGet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{16}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{19}
 }
 func (m *ClientGet) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClientGet.Unmarshal(m, b)
@@ -1286,7 +1630,7 @@ func (m *ClientSet) Reset()         { *m = ClientSet{} }
 func (m *ClientSet) String() string { return proto.CompactTextString(m) }
 func (*ClientSet) ProtoMessage()    {}
 func (*ClientSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{17}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{20}
 }
 func (m *ClientSet) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClientSet.Unmarshal(m, b)
@@ -1337,7 +1681,13 @@ type ClientDel struct {
 	// User ID of the subscription to delete
 	UserId string `protobuf:"bytes,5,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 	// Request to hard-delete messages for all users, if such option is available.
-	Hard                 bool     `protobuf:"varint,6,opt,name=hard" json:"hard,omitempty"`
+	Hard bool `protobuf:"varint,6,opt,name=hard" json:"hard,omitempty"`
+	// Delete contact message by id
+	DelCtMsgId   string `protobuf:"bytes,7,opt,name=del_ct_msg_id,json=delCtMsgId" json:"del_ct_msg_id,omitempty"`
+	DelCtUser    string `protobuf:"bytes,8,opt,name=del_ct_user,json=delCtUser" json:"del_ct_user,omitempty"`
+	DelCtContact string `protobuf:"bytes,9,opt,name=del_ct_contact,json=delCtContact" json:"del_ct_contact,omitempty"`
+	// Delete contact by id
+	DelCtId              string   `protobuf:"bytes,10,opt,name=del_ct_id,json=delCtId" json:"del_ct_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1347,7 +1697,7 @@ func (m *ClientDel) Reset()         { *m = ClientDel{} }
 func (m *ClientDel) String() string { return proto.CompactTextString(m) }
 func (*ClientDel) ProtoMessage()    {}
 func (*ClientDel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{18}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{21}
 }
 func (m *ClientDel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClientDel.Unmarshal(m, b)
@@ -1409,13 +1759,47 @@ func (m *ClientDel) GetHard() bool {
 	return false
 }
 
+func (m *ClientDel) GetDelCtMsgId() string {
+	if m != nil {
+		return m.DelCtMsgId
+	}
+	return ""
+}
+
+func (m *ClientDel) GetDelCtUser() string {
+	if m != nil {
+		return m.DelCtUser
+	}
+	return ""
+}
+
+func (m *ClientDel) GetDelCtContact() string {
+	if m != nil {
+		return m.DelCtContact
+	}
+	return ""
+}
+
+func (m *ClientDel) GetDelCtId() string {
+	if m != nil {
+		return m.DelCtId
+	}
+	return ""
+}
+
 // ClientNote is a client-generated notification for topic subscribers
 type ClientNote struct {
 	Topic string `protobuf:"bytes,1,opt,name=topic" json:"topic,omitempty"`
 	// what is being reported: "recv" - message received, "read" - message read, "kp" - typing notification
 	What InfoNote `protobuf:"varint,2,opt,name=what,enum=pbx.InfoNote" json:"what,omitempty"`
 	// Server-issued message ID being reported
-	SeqId                int32    `protobuf:"varint,3,opt,name=seq_id,json=seqId" json:"seq_id,omitempty"`
+	SeqId int32 `protobuf:"varint,3,opt,name=seq_id,json=seqId" json:"seq_id,omitempty"`
+	// Server-issued contact message ID being reported
+	ContactId string `protobuf:"bytes,4,opt,name=contact_id,json=contactId" json:"contact_id,omitempty"`
+	// Contact message state
+	ContactState int32 `protobuf:"varint,5,opt,name=contact_state,json=contactState" json:"contact_state,omitempty"`
+	// Emoji of the reaction
+	Emoji                string   `protobuf:"bytes,6,opt,name=emoji" json:"emoji,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1425,7 +1809,7 @@ func (m *ClientNote) Reset()         { *m = ClientNote{} }
 func (m *ClientNote) String() string { return proto.CompactTextString(m) }
 func (*ClientNote) ProtoMessage()    {}
 func (*ClientNote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{19}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{22}
 }
 func (m *ClientNote) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClientNote.Unmarshal(m, b)
@@ -1466,57 +1850,281 @@ func (m *ClientNote) GetSeqId() int32 {
 	return 0
 }
 
-type ClientMsg struct {
-	// Types that are valid to be assigned to Message:
-	//	*ClientMsg_Hi
-	//	*ClientMsg_Acc
-	//	*ClientMsg_Login
-	//	*ClientMsg_Sub
-	//	*ClientMsg_Leave
-	//	*ClientMsg_Pub
-	//	*ClientMsg_Get
-	//	*ClientMsg_Set
-	//	*ClientMsg_Del
-	//	*ClientMsg_Note
-	Message isClientMsg_Message `protobuf_oneof:"Message"`
-	// Root user may send messages on behalf of other users.
-	OnBehalfOf           string    `protobuf:"bytes,11,opt,name=on_behalf_of,json=onBehalfOf" json:"on_behalf_of,omitempty"`
-	AuthLevel            AuthLevel `protobuf:"varint,12,opt,name=auth_level,json=authLevel,enum=pbx.AuthLevel" json:"auth_level,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *ClientNote) GetContactId() string {
+	if m != nil {
+		return m.ContactId
+	}
+	return ""
 }
 
-func (m *ClientMsg) Reset()         { *m = ClientMsg{} }
-func (m *ClientMsg) String() string { return proto.CompactTextString(m) }
-func (*ClientMsg) ProtoMessage()    {}
-func (*ClientMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{20}
+func (m *ClientNote) GetContactState() int32 {
+	if m != nil {
+		return m.ContactState
+	}
+	return 0
 }
-func (m *ClientMsg) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ClientMsg.Unmarshal(m, b)
+
+func (m *ClientNote) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
 }
-func (m *ClientMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ClientMsg.Marshal(b, m, deterministic)
+
+// Contact request {contact} message
+type ClientContact struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Sender's 'me' topic
+	Topic string `protobuf:"bytes,2,opt,name=topic" json:"topic,omitempty"`
+	// User ID of the sender
+	Sender string `protobuf:"bytes,3,opt,name=sender" json:"sender,omitempty"`
+	// User ID of the receiver
+	Receiver  string `protobuf:"bytes,4,opt,name=receiver" json:"receiver,omitempty"`
+	ContactId string `protobuf:"bytes,5,opt,name=contact_id,json=contactId" json:"contact_id,omitempty"`
+	// What is being requested: "add", "reject", "agree"
+	What string `protobuf:"bytes,6,opt,name=what" json:"what,omitempty"`
+	// Optional greeting sent with "add"
+	Message string `protobuf:"bytes,7,opt,name=message" json:"message,omitempty"`
+	// Where the receiver was found: "search", "qrcode", "card" or group topic name
+	Source string `protobuf:"bytes,8,opt,name=source" json:"source,omitempty"`
+	// Answer to the verification question of the receiver
+	Answer               string   `protobuf:"bytes,9,opt,name=answer" json:"answer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (dst *ClientMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClientMsg.Merge(dst, src)
+
+func (m *ClientContact) Reset()         { *m = ClientContact{} }
+func (m *ClientContact) String() string { return proto.CompactTextString(m) }
+func (*ClientContact) ProtoMessage()    {}
+func (*ClientContact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{23}
 }
-func (m *ClientMsg) XXX_Size() int {
-	return xxx_messageInfo_ClientMsg.Size(m)
+func (m *ClientContact) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClientContact.Unmarshal(m, b)
 }
-func (m *ClientMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClientMsg.DiscardUnknown(m)
+func (m *ClientContact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClientContact.Marshal(b, m, deterministic)
+}
+func (dst *ClientContact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientContact.Merge(dst, src)
+}
+func (m *ClientContact) XXX_Size() int {
+	return xxx_messageInfo_ClientContact.Size(m)
+}
+func (m *ClientContact) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientContact.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClientMsg proto.InternalMessageInfo
+var xxx_messageInfo_ClientContact proto.InternalMessageInfo
 
-type isClientMsg_Message interface {
-	isClientMsg_Message()
+func (m *ClientContact) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-type ClientMsg_Hi struct {
-	Hi *ClientHi `protobuf:"bytes,1,opt,name=hi,oneof"`
+func (m *ClientContact) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *ClientContact) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ClientContact) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *ClientContact) GetContactId() string {
+	if m != nil {
+		return m.ContactId
+	}
+	return ""
+}
+
+func (m *ClientContact) GetWhat() string {
+	if m != nil {
+		return m.What
+	}
+	return ""
+}
+
+func (m *ClientContact) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ClientContact) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ClientContact) GetAnswer() string {
+	if m != nil {
+		return m.Answer
+	}
+	return ""
+}
+
+// Call signaling {signal} message
+type ClientSignal struct {
+	Id    string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Topic string `protobuf:"bytes,2,opt,name=topic" json:"topic,omitempty"`
+	// Topic the command is sent to
+	Target  string `protobuf:"bytes,3,opt,name=target" json:"target,omitempty"`
+	Command string `protobuf:"bytes,4,opt,name=command" json:"command,omitempty"`
+	// Room ID
+	Room string `protobuf:"bytes,5,opt,name=room" json:"room,omitempty"`
+	// Single peer to send the command to instead of all subscribers of the target
+	User string `protobuf:"bytes,6,opt,name=user" json:"user,omitempty"`
+	// Opaque payload, such as SDP offer/answer or ICE candidate, JSON-encoded
+	Payload              []byte   `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClientSignal) Reset()         { *m = ClientSignal{} }
+func (m *ClientSignal) String() string { return proto.CompactTextString(m) }
+func (*ClientSignal) ProtoMessage()    {}
+func (*ClientSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{24}
+}
+func (m *ClientSignal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClientSignal.Unmarshal(m, b)
+}
+func (m *ClientSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClientSignal.Marshal(b, m, deterministic)
+}
+func (dst *ClientSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientSignal.Merge(dst, src)
+}
+func (m *ClientSignal) XXX_Size() int {
+	return xxx_messageInfo_ClientSignal.Size(m)
+}
+func (m *ClientSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientSignal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientSignal proto.InternalMessageInfo
+
+func (m *ClientSignal) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ClientSignal) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *ClientSignal) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *ClientSignal) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *ClientSignal) GetRoom() string {
+	if m != nil {
+		return m.Room
+	}
+	return ""
+}
+
+func (m *ClientSignal) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *ClientSignal) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type ClientMsg struct {
+	// Types that are valid to be assigned to Message:
+	//	*ClientMsg_Hi
+	//	*ClientMsg_Acc
+	//	*ClientMsg_Login
+	//	*ClientMsg_Sub
+	//	*ClientMsg_Leave
+	//	*ClientMsg_Pub
+	//	*ClientMsg_Get
+	//	*ClientMsg_Set
+	//	*ClientMsg_Del
+	//	*ClientMsg_Note
+	//	*ClientMsg_Contact
+	//	*ClientMsg_Signal
+	Message isClientMsg_Message `protobuf_oneof:"Message"`
+	// Root user may send messages on behalf of other users.
+	OnBehalfOf           string    `protobuf:"bytes,11,opt,name=on_behalf_of,json=onBehalfOf" json:"on_behalf_of,omitempty"`
+	AuthLevel            AuthLevel `protobuf:"varint,12,opt,name=auth_level,json=authLevel,enum=pbx.AuthLevel" json:"auth_level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ClientMsg) Reset()         { *m = ClientMsg{} }
+func (m *ClientMsg) String() string { return proto.CompactTextString(m) }
+func (*ClientMsg) ProtoMessage()    {}
+func (*ClientMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{25}
+}
+func (m *ClientMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClientMsg.Unmarshal(m, b)
+}
+func (m *ClientMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClientMsg.Marshal(b, m, deterministic)
+}
+func (dst *ClientMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientMsg.Merge(dst, src)
+}
+func (m *ClientMsg) XXX_Size() int {
+	return xxx_messageInfo_ClientMsg.Size(m)
+}
+func (m *ClientMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientMsg proto.InternalMessageInfo
+
+type isClientMsg_Message interface {
+	isClientMsg_Message()
+}
+
+type ClientMsg_Hi struct {
+	Hi *ClientHi `protobuf:"bytes,1,opt,name=hi,oneof"`
 }
 type ClientMsg_Acc struct {
 	Acc *ClientAcc `protobuf:"bytes,2,opt,name=acc,oneof"`
@@ -1545,17 +2153,25 @@ type ClientMsg_Del struct {
 type ClientMsg_Note struct {
 	Note *ClientNote `protobuf:"bytes,10,opt,name=note,oneof"`
 }
+type ClientMsg_Contact struct {
+	Contact *ClientContact `protobuf:"bytes,13,opt,name=contact,oneof"`
+}
+type ClientMsg_Signal struct {
+	Signal *ClientSignal `protobuf:"bytes,14,opt,name=signal,oneof"`
+}
 
-func (*ClientMsg_Hi) isClientMsg_Message()    {}
-func (*ClientMsg_Acc) isClientMsg_Message()   {}
-func (*ClientMsg_Login) isClientMsg_Message() {}
-func (*ClientMsg_Sub) isClientMsg_Message()   {}
-func (*ClientMsg_Leave) isClientMsg_Message() {}
-func (*ClientMsg_Pub) isClientMsg_Message()   {}
-func (*ClientMsg_Get) isClientMsg_Message()   {}
-func (*ClientMsg_Set) isClientMsg_Message()   {}
-func (*ClientMsg_Del) isClientMsg_Message()   {}
-func (*ClientMsg_Note) isClientMsg_Message()  {}
+func (*ClientMsg_Hi) isClientMsg_Message()      {}
+func (*ClientMsg_Acc) isClientMsg_Message()     {}
+func (*ClientMsg_Login) isClientMsg_Message()   {}
+func (*ClientMsg_Sub) isClientMsg_Message()     {}
+func (*ClientMsg_Leave) isClientMsg_Message()   {}
+func (*ClientMsg_Pub) isClientMsg_Message()     {}
+func (*ClientMsg_Get) isClientMsg_Message()     {}
+func (*ClientMsg_Set) isClientMsg_Message()     {}
+func (*ClientMsg_Del) isClientMsg_Message()     {}
+func (*ClientMsg_Note) isClientMsg_Message()    {}
+func (*ClientMsg_Contact) isClientMsg_Message() {}
+func (*ClientMsg_Signal) isClientMsg_Message()  {}
 
 func (m *ClientMsg) GetMessage() isClientMsg_Message {
 	if m != nil {
@@ -1634,6 +2250,20 @@ func (m *ClientMsg) GetNote() *ClientNote {
 	return nil
 }
 
+func (m *ClientMsg) GetContact() *ClientContact {
+	if x, ok := m.GetMessage().(*ClientMsg_Contact); ok {
+		return x.Contact
+	}
+	return nil
+}
+
+func (m *ClientMsg) GetSignal() *ClientSignal {
+	if x, ok := m.GetMessage().(*ClientMsg_Signal); ok {
+		return x.Signal
+	}
+	return nil
+}
+
 func (m *ClientMsg) GetOnBehalfOf() string {
 	if m != nil {
 		return m.OnBehalfOf
@@ -1661,6 +2291,8 @@ func (*ClientMsg) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) err
 		(*ClientMsg_Set)(nil),
 		(*ClientMsg_Del)(nil),
 		(*ClientMsg_Note)(nil),
+		(*ClientMsg_Contact)(nil),
+		(*ClientMsg_Signal)(nil),
 	}
 }
 
@@ -1718,6 +2350,16 @@ func _ClientMsg_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Note); err != nil {
 			return err
 		}
+	case *ClientMsg_Contact:
+		b.EncodeVarint(13<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Contact); err != nil {
+			return err
+		}
+	case *ClientMsg_Signal:
+		b.EncodeVarint(14<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Signal); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ClientMsg.Message has unexpected type %T", x)
@@ -1808,6 +2450,22 @@ func _ClientMsg_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buff
 		err := b.DecodeMessage(msg)
 		m.Message = &ClientMsg_Note{msg}
 		return true, err
+	case 13: // Message.contact
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ClientContact)
+		err := b.DecodeMessage(msg)
+		m.Message = &ClientMsg_Contact{msg}
+		return true, err
+	case 14: // Message.signal
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ClientSignal)
+		err := b.DecodeMessage(msg)
+		m.Message = &ClientMsg_Signal{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1867,6 +2525,16 @@ func _ClientMsg_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ClientMsg_Contact:
+		s := proto.Size(x.Contact)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ClientMsg_Signal:
+		s := proto.Size(x.Signal)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1896,7 +2564,7 @@ func (m *TopicDesc) Reset()         { *m = TopicDesc{} }
 func (m *TopicDesc) String() string { return proto.CompactTextString(m) }
 func (*TopicDesc) ProtoMessage()    {}
 func (*TopicDesc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{21}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{26}
 }
 func (m *TopicDesc) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TopicDesc.Unmarshal(m, b)
@@ -2024,7 +2692,7 @@ func (m *TopicSub) Reset()         { *m = TopicSub{} }
 func (m *TopicSub) String() string { return proto.CompactTextString(m) }
 func (*TopicSub) ProtoMessage()    {}
 func (*TopicSub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{22}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{27}
 }
 func (m *TopicSub) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TopicSub.Unmarshal(m, b)
@@ -2161,7 +2829,7 @@ func (m *DelValues) Reset()         { *m = DelValues{} }
 func (m *DelValues) String() string { return proto.CompactTextString(m) }
 func (*DelValues) ProtoMessage()    {}
 func (*DelValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{23}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{28}
 }
 func (m *DelValues) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DelValues.Unmarshal(m, b)
@@ -2211,7 +2879,7 @@ func (m *ServerCtrl) Reset()         { *m = ServerCtrl{} }
 func (m *ServerCtrl) String() string { return proto.CompactTextString(m) }
 func (*ServerCtrl) ProtoMessage()    {}
 func (*ServerCtrl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{24}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{29}
 }
 func (m *ServerCtrl) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServerCtrl.Unmarshal(m, b)
@@ -2274,20 +2942,32 @@ type ServerData struct {
 	// Timestamp when the message was sent.
 	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp" json:"timestamp,omitempty"`
 	// Timestamp when the message was deleted or 0. Milliseconds since the epoch 01/01/1970
-	DeletedAt            int64             `protobuf:"varint,3,opt,name=deleted_at,json=deletedAt" json:"deleted_at,omitempty"`
-	SeqId                int32             `protobuf:"varint,4,opt,name=seq_id,json=seqId" json:"seq_id,omitempty"`
-	Head                 map[string][]byte `protobuf:"bytes,5,rep,name=head" json:"head,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Content              []byte            `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	DeletedAt int64             `protobuf:"varint,3,opt,name=deleted_at,json=deletedAt" json:"deleted_at,omitempty"`
+	SeqId     int32             `protobuf:"varint,4,opt,name=seq_id,json=seqId" json:"seq_id,omitempty"`
+	Head      map[string][]byte `protobuf:"bytes,5,rep,name=head" json:"head,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Content   []byte            `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	// Timestamp of the last edit or 0 if the message was not edited. Milliseconds since the epoch 01/01/1970
+	EditedAt int64 `protobuf:"varint,8,opt,name=edited_at,json=editedAt" json:"edited_at,omitempty"`
+	// Earlier versions of the content of an edited message, oldest first
+	Revisions []*MessageRevision `protobuf:"bytes,9,rep,name=revisions" json:"revisions,omitempty"`
+	// The message was recalled by the sender; content is removed
+	Recalled bool `protobuf:"varint,10,opt,name=recalled" json:"recalled,omitempty"`
+	// Counts of emoji reactions to the message
+	Reactions []*MessageReaction `protobuf:"bytes,11,rep,name=reactions" json:"reactions,omitempty"`
+	// Seq ID of the message this message replies to
+	ReplyTo int32 `protobuf:"varint,12,opt,name=reply_to,json=replyTo" json:"reply_to,omitempty"`
+	// Number of replies to the message
+	Replies              int32    `protobuf:"varint,13,opt,name=replies" json:"replies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ServerData) Reset()         { *m = ServerData{} }
 func (m *ServerData) String() string { return proto.CompactTextString(m) }
 func (*ServerData) ProtoMessage()    {}
 func (*ServerData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{25}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{30}
 }
 func (m *ServerData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServerData.Unmarshal(m, b)
@@ -2356,202 +3036,931 @@ func (m *ServerData) GetContent() []byte {
 	return nil
 }
 
-// {pres} message
-type ServerPres struct {
-	Topic                string          `protobuf:"bytes,1,opt,name=topic" json:"topic,omitempty"`
-	Src                  string          `protobuf:"bytes,2,opt,name=src" json:"src,omitempty"`
-	What                 ServerPres_What `protobuf:"varint,3,opt,name=what,enum=pbx.ServerPres_What" json:"what,omitempty"`
-	UserAgent            string          `protobuf:"bytes,4,opt,name=user_agent,json=userAgent" json:"user_agent,omitempty"`
-	SeqId                int32           `protobuf:"varint,5,opt,name=seq_id,json=seqId" json:"seq_id,omitempty"`
-	DelId                int32           `protobuf:"varint,6,opt,name=del_id,json=delId" json:"del_id,omitempty"`
-	DelSeq               []*SeqRange     `protobuf:"bytes,7,rep,name=del_seq,json=delSeq" json:"del_seq,omitempty"`
-	TargetUserId         string          `protobuf:"bytes,8,opt,name=target_user_id,json=targetUserId" json:"target_user_id,omitempty"`
-	ActorUserId          string          `protobuf:"bytes,9,opt,name=actor_user_id,json=actorUserId" json:"actor_user_id,omitempty"`
-	Acs                  *AccessMode     `protobuf:"bytes,10,opt,name=acs" json:"acs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *ServerData) GetEditedAt() int64 {
+	if m != nil {
+		return m.EditedAt
+	}
+	return 0
 }
 
-func (m *ServerPres) Reset()         { *m = ServerPres{} }
-func (m *ServerPres) String() string { return proto.CompactTextString(m) }
-func (*ServerPres) ProtoMessage()    {}
-func (*ServerPres) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{26}
-}
-func (m *ServerPres) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ServerPres.Unmarshal(m, b)
-}
-func (m *ServerPres) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ServerPres.Marshal(b, m, deterministic)
-}
-func (dst *ServerPres) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ServerPres.Merge(dst, src)
-}
-func (m *ServerPres) XXX_Size() int {
-	return xxx_messageInfo_ServerPres.Size(m)
-}
-func (m *ServerPres) XXX_DiscardUnknown() {
-	xxx_messageInfo_ServerPres.DiscardUnknown(m)
+func (m *ServerData) GetRevisions() []*MessageRevision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
 }
 
-var xxx_messageInfo_ServerPres proto.InternalMessageInfo
+func (m *ServerData) GetRecalled() bool {
+	if m != nil {
+		return m.Recalled
+	}
+	return false
+}
 
-func (m *ServerPres) GetTopic() string {
+func (m *ServerData) GetReactions() []*MessageReaction {
 	if m != nil {
-		return m.Topic
+		return m.Reactions
 	}
-	return ""
+	return nil
 }
 
-func (m *ServerPres) GetSrc() string {
+func (m *ServerData) GetReplyTo() int32 {
 	if m != nil {
-		return m.Src
+		return m.ReplyTo
 	}
-	return ""
+	return 0
 }
 
-func (m *ServerPres) GetWhat() ServerPres_What {
+func (m *ServerData) GetReplies() int32 {
 	if m != nil {
-		return m.What
+		return m.Replies
 	}
-	return ServerPres_ON
+	return 0
 }
 
-func (m *ServerPres) GetUserAgent() string {
+// Number of users who reacted to a message with the same emoji
+type MessageReaction struct {
+	Emoji string `protobuf:"bytes,1,opt,name=emoji" json:"emoji,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+	// The requesting user is one of those who reacted
+	Mine                 bool     `protobuf:"varint,3,opt,name=mine" json:"mine,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MessageReaction) Reset()         { *m = MessageReaction{} }
+func (m *MessageReaction) String() string { return proto.CompactTextString(m) }
+func (*MessageReaction) ProtoMessage()    {}
+func (*MessageReaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{31}
+}
+func (m *MessageReaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MessageReaction.Unmarshal(m, b)
+}
+func (m *MessageReaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MessageReaction.Marshal(b, m, deterministic)
+}
+func (dst *MessageReaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageReaction.Merge(dst, src)
+}
+func (m *MessageReaction) XXX_Size() int {
+	return xxx_messageInfo_MessageReaction.Size(m)
+}
+func (m *MessageReaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageReaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageReaction proto.InternalMessageInfo
+
+func (m *MessageReaction) GetEmoji() string {
 	if m != nil {
-		return m.UserAgent
+		return m.Emoji
 	}
 	return ""
 }
 
-func (m *ServerPres) GetSeqId() int32 {
+func (m *MessageReaction) GetCount() int32 {
 	if m != nil {
-		return m.SeqId
+		return m.Count
 	}
 	return 0
 }
 
-func (m *ServerPres) GetDelId() int32 {
+func (m *MessageReaction) GetMine() bool {
 	if m != nil {
-		return m.DelId
+		return m.Mine
 	}
-	return 0
+	return false
+}
+
+// Earlier version of the content of an edited message
+type MessageRevision struct {
+	// Timestamp when this version was published
+	Timestamp            int64    `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	Content              []byte   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MessageRevision) Reset()         { *m = MessageRevision{} }
+func (m *MessageRevision) String() string { return proto.CompactTextString(m) }
+func (*MessageRevision) ProtoMessage()    {}
+func (*MessageRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{32}
+}
+func (m *MessageRevision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MessageRevision.Unmarshal(m, b)
+}
+func (m *MessageRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MessageRevision.Marshal(b, m, deterministic)
+}
+func (dst *MessageRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRevision.Merge(dst, src)
+}
+func (m *MessageRevision) XXX_Size() int {
+	return xxx_messageInfo_MessageRevision.Size(m)
+}
+func (m *MessageRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageRevision proto.InternalMessageInfo
+
+func (m *MessageRevision) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *MessageRevision) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+// {pres} message
+type ServerPres struct {
+	Topic        string          `protobuf:"bytes,1,opt,name=topic" json:"topic,omitempty"`
+	Src          string          `protobuf:"bytes,2,opt,name=src" json:"src,omitempty"`
+	What         ServerPres_What `protobuf:"varint,3,opt,name=what,enum=pbx.ServerPres_What" json:"what,omitempty"`
+	UserAgent    string          `protobuf:"bytes,4,opt,name=user_agent,json=userAgent" json:"user_agent,omitempty"`
+	SeqId        int32           `protobuf:"varint,5,opt,name=seq_id,json=seqId" json:"seq_id,omitempty"`
+	DelId        int32           `protobuf:"varint,6,opt,name=del_id,json=delId" json:"del_id,omitempty"`
+	DelSeq       []*SeqRange     `protobuf:"bytes,7,rep,name=del_seq,json=delSeq" json:"del_seq,omitempty"`
+	TargetUserId string          `protobuf:"bytes,8,opt,name=target_user_id,json=targetUserId" json:"target_user_id,omitempty"`
+	ActorUserId  string          `protobuf:"bytes,9,opt,name=actor_user_id,json=actorUserId" json:"actor_user_id,omitempty"`
+	Acs          *AccessMode     `protobuf:"bytes,10,opt,name=acs" json:"acs,omitempty"`
+	// Contact message ID
+	ContactId string `protobuf:"bytes,11,opt,name=contact_id,json=contactId" json:"contact_id,omitempty"`
+	// Call signaling fields
+	SgAction             string   `protobuf:"bytes,12,opt,name=sg_action,json=sgAction" json:"sg_action,omitempty"`
+	Room                 string   `protobuf:"bytes,13,opt,name=room" json:"room,omitempty"`
+	UserId               string   `protobuf:"bytes,14,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+	Public               []byte   `protobuf:"bytes,15,opt,name=public,proto3" json:"public,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServerPres) Reset()         { *m = ServerPres{} }
+func (m *ServerPres) String() string { return proto.CompactTextString(m) }
+func (*ServerPres) ProtoMessage()    {}
+func (*ServerPres) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{33}
+}
+func (m *ServerPres) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServerPres.Unmarshal(m, b)
+}
+func (m *ServerPres) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServerPres.Marshal(b, m, deterministic)
+}
+func (dst *ServerPres) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServerPres.Merge(dst, src)
+}
+func (m *ServerPres) XXX_Size() int {
+	return xxx_messageInfo_ServerPres.Size(m)
+}
+func (m *ServerPres) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServerPres.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServerPres proto.InternalMessageInfo
+
+func (m *ServerPres) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *ServerPres) GetSrc() string {
+	if m != nil {
+		return m.Src
+	}
+	return ""
+}
+
+func (m *ServerPres) GetWhat() ServerPres_What {
+	if m != nil {
+		return m.What
+	}
+	return ServerPres_ON
+}
+
+func (m *ServerPres) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+func (m *ServerPres) GetSeqId() int32 {
+	if m != nil {
+		return m.SeqId
+	}
+	return 0
+}
+
+func (m *ServerPres) GetDelId() int32 {
+	if m != nil {
+		return m.DelId
+	}
+	return 0
+}
+
+func (m *ServerPres) GetDelSeq() []*SeqRange {
+	if m != nil {
+		return m.DelSeq
+	}
+	return nil
+}
+
+func (m *ServerPres) GetTargetUserId() string {
+	if m != nil {
+		return m.TargetUserId
+	}
+	return ""
+}
+
+func (m *ServerPres) GetActorUserId() string {
+	if m != nil {
+		return m.ActorUserId
+	}
+	return ""
+}
+
+func (m *ServerPres) GetAcs() *AccessMode {
+	if m != nil {
+		return m.Acs
+	}
+	return nil
+}
+
+func (m *ServerPres) GetContactId() string {
+	if m != nil {
+		return m.ContactId
+	}
+	return ""
+}
+
+func (m *ServerPres) GetSgAction() string {
+	if m != nil {
+		return m.SgAction
+	}
+	return ""
+}
+
+func (m *ServerPres) GetRoom() string {
+	if m != nil {
+		return m.Room
+	}
+	return ""
+}
+
+func (m *ServerPres) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ServerPres) GetPublic() []byte {
+	if m != nil {
+		return m.Public
+	}
+	return nil
+}
+
+// Contact message, sent in Meta message
+type ContactMsg struct {
+	Id        string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	CreatedAt int64  `protobuf:"varint,2,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	Sender    string `protobuf:"bytes,3,opt,name=sender" json:"sender,omitempty"`
+	Receiver  string `protobuf:"bytes,4,opt,name=receiver" json:"receiver,omitempty"`
+	State     int32  `protobuf:"varint,5,opt,name=state" json:"state,omitempty"`
+	Public    []byte `protobuf:"bytes,6,opt,name=public,proto3" json:"public,omitempty"`
+	Message   string `protobuf:"bytes,7,opt,name=message" json:"message,omitempty"`
+	Source    string `protobuf:"bytes,8,opt,name=source" json:"source,omitempty"`
+	// Expiration time of a pending request, 0 if it never expires
+	ExpiresAt            int64    `protobuf:"varint,9,opt,name=expires_at,json=expiresAt" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContactMsg) Reset()         { *m = ContactMsg{} }
+func (m *ContactMsg) String() string { return proto.CompactTextString(m) }
+func (*ContactMsg) ProtoMessage()    {}
+func (*ContactMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{34}
+}
+func (m *ContactMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContactMsg.Unmarshal(m, b)
+}
+func (m *ContactMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContactMsg.Marshal(b, m, deterministic)
+}
+func (dst *ContactMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactMsg.Merge(dst, src)
+}
+func (m *ContactMsg) XXX_Size() int {
+	return xxx_messageInfo_ContactMsg.Size(m)
+}
+func (m *ContactMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContactMsg proto.InternalMessageInfo
+
+func (m *ContactMsg) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ContactMsg) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ContactMsg) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ContactMsg) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *ContactMsg) GetState() int32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+func (m *ContactMsg) GetPublic() []byte {
+	if m != nil {
+		return m.Public
+	}
+	return nil
+}
+
+func (m *ContactMsg) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ContactMsg) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ContactMsg) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// Contact details, sent in Meta message
+type Contact struct {
+	Id        string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	CreatedAt int64  `protobuf:"varint,2,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	UserId    string `protobuf:"bytes,3,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+	ContactId string `protobuf:"bytes,4,opt,name=contact_id,json=contactId" json:"contact_id,omitempty"`
+	Public    []byte `protobuf:"bytes,5,opt,name=public,proto3" json:"public,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt" json:"updated_at,omitempty"`
+	// Set if the friendship was removed
+	DeletedAt            int64    `protobuf:"varint,7,opt,name=deleted_at,json=deletedAt" json:"deleted_at,omitempty"`
+	Remark               string   `protobuf:"bytes,8,opt,name=remark" json:"remark,omitempty"`
+	Labels               []string `protobuf:"bytes,9,rep,name=labels" json:"labels,omitempty"`
+	Starred              bool     `protobuf:"varint,10,opt,name=starred" json:"starred,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Contact) Reset()         { *m = Contact{} }
+func (m *Contact) String() string { return proto.CompactTextString(m) }
+func (*Contact) ProtoMessage()    {}
+func (*Contact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{35}
+}
+func (m *Contact) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Contact.Unmarshal(m, b)
+}
+func (m *Contact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Contact.Marshal(b, m, deterministic)
+}
+func (dst *Contact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Contact.Merge(dst, src)
+}
+func (m *Contact) XXX_Size() int {
+	return xxx_messageInfo_Contact.Size(m)
+}
+func (m *Contact) XXX_DiscardUnknown() {
+	xxx_messageInfo_Contact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Contact proto.InternalMessageInfo
+
+func (m *Contact) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Contact) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Contact) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Contact) GetContactId() string {
+	if m != nil {
+		return m.ContactId
+	}
+	return ""
+}
+
+func (m *Contact) GetPublic() []byte {
+	if m != nil {
+		return m.Public
+	}
+	return nil
+}
+
+func (m *Contact) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *Contact) GetDeletedAt() int64 {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return 0
+}
+
+func (m *Contact) GetRemark() string {
+	if m != nil {
+		return m.Remark
+	}
+	return ""
+}
+
+func (m *Contact) GetLabels() []string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Contact) GetStarred() bool {
+	if m != nil {
+		return m.Starred
+	}
+	return false
+}
+
+// {meta} message
+type ServerMeta struct {
+	Id                   string        `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Topic                string        `protobuf:"bytes,2,opt,name=topic" json:"topic,omitempty"`
+	Desc                 *TopicDesc    `protobuf:"bytes,3,opt,name=desc" json:"desc,omitempty"`
+	Sub                  []*TopicSub   `protobuf:"bytes,4,rep,name=sub" json:"sub,omitempty"`
+	Del                  *DelValues    `protobuf:"bytes,5,opt,name=del" json:"del,omitempty"`
+	Tags                 []string      `protobuf:"bytes,6,rep,name=tags" json:"tags,omitempty"`
+	Ctmsg                []*ContactMsg `protobuf:"bytes,7,rep,name=ctmsg" json:"ctmsg,omitempty"`
+	Contact              []*Contact    `protobuf:"bytes,8,rep,name=contact" json:"contact,omitempty"`
+	Ice                  []*IceServer  `protobuf:"bytes,9,rep,name=ice" json:"ice,omitempty"`
+	Call                 *CallInfo     `protobuf:"bytes,10,opt,name=call" json:"call,omitempty"`
+	Blocked              []string      `protobuf:"bytes,11,rep,name=blocked" json:"blocked,omitempty"`
+	Privacy              *Privacy      `protobuf:"bytes,12,opt,name=privacy" json:"privacy,omitempty"`
+	Search               []*SearchHit  `protobuf:"bytes,13,rep,name=search" json:"search,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ServerMeta) Reset()         { *m = ServerMeta{} }
+func (m *ServerMeta) String() string { return proto.CompactTextString(m) }
+func (*ServerMeta) ProtoMessage()    {}
+func (*ServerMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{36}
+}
+func (m *ServerMeta) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServerMeta.Unmarshal(m, b)
+}
+func (m *ServerMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServerMeta.Marshal(b, m, deterministic)
+}
+func (dst *ServerMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServerMeta.Merge(dst, src)
+}
+func (m *ServerMeta) XXX_Size() int {
+	return xxx_messageInfo_ServerMeta.Size(m)
+}
+func (m *ServerMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServerMeta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServerMeta proto.InternalMessageInfo
+
+func (m *ServerMeta) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ServerMeta) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *ServerMeta) GetDesc() *TopicDesc {
+	if m != nil {
+		return m.Desc
+	}
+	return nil
+}
+
+func (m *ServerMeta) GetSub() []*TopicSub {
+	if m != nil {
+		return m.Sub
+	}
+	return nil
+}
+
+func (m *ServerMeta) GetDel() *DelValues {
+	if m != nil {
+		return m.Del
+	}
+	return nil
+}
+
+func (m *ServerMeta) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ServerMeta) GetCtmsg() []*ContactMsg {
+	if m != nil {
+		return m.Ctmsg
+	}
+	return nil
+}
+
+func (m *ServerMeta) GetContact() []*Contact {
+	if m != nil {
+		return m.Contact
+	}
+	return nil
+}
+
+func (m *ServerMeta) GetIce() []*IceServer {
+	if m != nil {
+		return m.Ice
+	}
+	return nil
+}
+
+func (m *ServerMeta) GetCall() *CallInfo {
+	if m != nil {
+		return m.Call
+	}
+	return nil
+}
+
+func (m *ServerMeta) GetBlocked() []string {
+	if m != nil {
+		return m.Blocked
+	}
+	return nil
+}
+
+func (m *ServerMeta) GetPrivacy() *Privacy {
+	if m != nil {
+		return m.Privacy
+	}
+	return nil
+}
+
+func (m *ServerMeta) GetSearch() []*SearchHit {
+	if m != nil {
+		return m.Search
+	}
+	return nil
+}
+
+// Message found by a full-text search
+type SearchHit struct {
+	Topic      string `protobuf:"bytes,1,opt,name=topic" json:"topic,omitempty"`
+	SeqId      int32  `protobuf:"varint,2,opt,name=seq_id,json=seqId" json:"seq_id,omitempty"`
+	FromUserId string `protobuf:"bytes,3,opt,name=from_user_id,json=fromUserId" json:"from_user_id,omitempty"`
+	Timestamp  int64  `protobuf:"varint,4,opt,name=timestamp" json:"timestamp,omitempty"`
+	// Fragment of the plain text of the message
+	Text string `protobuf:"bytes,5,opt,name=text" json:"text,omitempty"`
+	// Positions of the matched words in the fragment
+	Highlights           []*Highlight `protobuf:"bytes,6,rep,name=highlights" json:"highlights,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SearchHit) Reset()         { *m = SearchHit{} }
+func (m *SearchHit) String() string { return proto.CompactTextString(m) }
+func (*SearchHit) ProtoMessage()    {}
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{37}
+}
+func (m *SearchHit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchHit.Unmarshal(m, b)
+}
+func (m *SearchHit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchHit.Marshal(b, m, deterministic)
+}
+func (dst *SearchHit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchHit.Merge(dst, src)
+}
+func (m *SearchHit) XXX_Size() int {
+	return xxx_messageInfo_SearchHit.Size(m)
+}
+func (m *SearchHit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchHit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchHit proto.InternalMessageInfo
+
+func (m *SearchHit) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *SearchHit) GetSeqId() int32 {
+	if m != nil {
+		return m.SeqId
+	}
+	return 0
+}
+
+func (m *SearchHit) GetFromUserId() string {
+	if m != nil {
+		return m.FromUserId
+	}
+	return ""
+}
+
+func (m *SearchHit) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *SearchHit) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *SearchHit) GetHighlights() []*Highlight {
+	if m != nil {
+		return m.Highlights
+	}
+	return nil
+}
+
+// Position of a matched word in text, in characters
+type Highlight struct {
+	At                   int32    `protobuf:"varint,1,opt,name=at" json:"at,omitempty"`
+	Len                  int32    `protobuf:"varint,2,opt,name=len" json:"len,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Highlight) Reset()         { *m = Highlight{} }
+func (m *Highlight) String() string { return proto.CompactTextString(m) }
+func (*Highlight) ProtoMessage()    {}
+func (*Highlight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{38}
+}
+func (m *Highlight) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Highlight.Unmarshal(m, b)
+}
+func (m *Highlight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Highlight.Marshal(b, m, deterministic)
+}
+func (dst *Highlight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Highlight.Merge(dst, src)
+}
+func (m *Highlight) XXX_Size() int {
+	return xxx_messageInfo_Highlight.Size(m)
+}
+func (m *Highlight) XXX_DiscardUnknown() {
+	xxx_messageInfo_Highlight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Highlight proto.InternalMessageInfo
+
+func (m *Highlight) GetAt() int32 {
+	if m != nil {
+		return m.At
+	}
+	return 0
+}
+
+func (m *Highlight) GetLen() int32 {
+	if m != nil {
+		return m.Len
+	}
+	return 0
+}
+
+// STUN or TURN server for audio and video calls
+type IceServer struct {
+	Urls []string `protobuf:"bytes,1,rep,name=urls" json:"urls,omitempty"`
+	// TURN credentials
+	Username   string `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
+	Credential string `protobuf:"bytes,3,opt,name=credential" json:"credential,omitempty"`
+	// Expiration time of the credentials
+	Expires              int64    `protobuf:"varint,4,opt,name=expires" json:"expires,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IceServer) Reset()         { *m = IceServer{} }
+func (m *IceServer) String() string { return proto.CompactTextString(m) }
+func (*IceServer) ProtoMessage()    {}
+func (*IceServer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{39}
+}
+func (m *IceServer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IceServer.Unmarshal(m, b)
+}
+func (m *IceServer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IceServer.Marshal(b, m, deterministic)
+}
+func (dst *IceServer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IceServer.Merge(dst, src)
+}
+func (m *IceServer) XXX_Size() int {
+	return xxx_messageInfo_IceServer.Size(m)
+}
+func (m *IceServer) XXX_DiscardUnknown() {
+	xxx_messageInfo_IceServer.DiscardUnknown(m)
 }
 
-func (m *ServerPres) GetDelSeq() []*SeqRange {
+var xxx_messageInfo_IceServer proto.InternalMessageInfo
+
+func (m *IceServer) GetUrls() []string {
 	if m != nil {
-		return m.DelSeq
+		return m.Urls
 	}
 	return nil
 }
 
-func (m *ServerPres) GetTargetUserId() string {
+func (m *IceServer) GetUsername() string {
 	if m != nil {
-		return m.TargetUserId
+		return m.Username
 	}
 	return ""
 }
 
-func (m *ServerPres) GetActorUserId() string {
+func (m *IceServer) GetCredential() string {
 	if m != nil {
-		return m.ActorUserId
+		return m.Credential
 	}
 	return ""
 }
 
-func (m *ServerPres) GetAcs() *AccessMode {
+func (m *IceServer) GetExpires() int64 {
 	if m != nil {
-		return m.Acs
+		return m.Expires
 	}
-	return nil
+	return 0
 }
 
-// {meta} message
-type ServerMeta struct {
-	Id                   string      `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Topic                string      `protobuf:"bytes,2,opt,name=topic" json:"topic,omitempty"`
-	Desc                 *TopicDesc  `protobuf:"bytes,3,opt,name=desc" json:"desc,omitempty"`
-	Sub                  []*TopicSub `protobuf:"bytes,4,rep,name=sub" json:"sub,omitempty"`
-	Del                  *DelValues  `protobuf:"bytes,5,opt,name=del" json:"del,omitempty"`
-	Tags                 []string    `protobuf:"bytes,6,rep,name=tags" json:"tags,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+// Active group call
+type CallInfo struct {
+	Room  string `protobuf:"bytes,1,opt,name=room" json:"room,omitempty"`
+	Media string `protobuf:"bytes,2,opt,name=media" json:"media,omitempty"`
+	State string `protobuf:"bytes,3,opt,name=state" json:"state,omitempty"`
+	// Users currently in the room
+	Participants         []string `protobuf:"bytes,4,rep,name=participants" json:"participants,omitempty"`
+	Started              int64    `protobuf:"varint,5,opt,name=started" json:"started,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ServerMeta) Reset()         { *m = ServerMeta{} }
-func (m *ServerMeta) String() string { return proto.CompactTextString(m) }
-func (*ServerMeta) ProtoMessage()    {}
-func (*ServerMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{27}
+func (m *CallInfo) Reset()         { *m = CallInfo{} }
+func (m *CallInfo) String() string { return proto.CompactTextString(m) }
+func (*CallInfo) ProtoMessage()    {}
+func (*CallInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{40}
 }
-func (m *ServerMeta) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ServerMeta.Unmarshal(m, b)
+func (m *CallInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallInfo.Unmarshal(m, b)
 }
-func (m *ServerMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ServerMeta.Marshal(b, m, deterministic)
+func (m *CallInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallInfo.Marshal(b, m, deterministic)
 }
-func (dst *ServerMeta) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ServerMeta.Merge(dst, src)
+func (dst *CallInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallInfo.Merge(dst, src)
 }
-func (m *ServerMeta) XXX_Size() int {
-	return xxx_messageInfo_ServerMeta.Size(m)
+func (m *CallInfo) XXX_Size() int {
+	return xxx_messageInfo_CallInfo.Size(m)
 }
-func (m *ServerMeta) XXX_DiscardUnknown() {
-	xxx_messageInfo_ServerMeta.DiscardUnknown(m)
+func (m *CallInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ServerMeta proto.InternalMessageInfo
+var xxx_messageInfo_CallInfo proto.InternalMessageInfo
 
-func (m *ServerMeta) GetId() string {
+func (m *CallInfo) GetRoom() string {
 	if m != nil {
-		return m.Id
+		return m.Room
 	}
 	return ""
 }
 
-func (m *ServerMeta) GetTopic() string {
+func (m *CallInfo) GetMedia() string {
 	if m != nil {
-		return m.Topic
+		return m.Media
 	}
 	return ""
 }
 
-func (m *ServerMeta) GetDesc() *TopicDesc {
-	if m != nil {
-		return m.Desc
-	}
-	return nil
-}
-
-func (m *ServerMeta) GetSub() []*TopicSub {
+func (m *CallInfo) GetState() string {
 	if m != nil {
-		return m.Sub
+		return m.State
 	}
-	return nil
+	return ""
 }
 
-func (m *ServerMeta) GetDel() *DelValues {
+func (m *CallInfo) GetParticipants() []string {
 	if m != nil {
-		return m.Del
+		return m.Participants
 	}
 	return nil
 }
 
-func (m *ServerMeta) GetTags() []string {
+func (m *CallInfo) GetStarted() int64 {
 	if m != nil {
-		return m.Tags
+		return m.Started
 	}
-	return nil
+	return 0
 }
 
 // {info} message: server-side copy of ClientNote with From added
 type ServerInfo struct {
-	Topic                string   `protobuf:"bytes,1,opt,name=topic" json:"topic,omitempty"`
-	FromUserId           string   `protobuf:"bytes,2,opt,name=from_user_id,json=fromUserId" json:"from_user_id,omitempty"`
-	What                 InfoNote `protobuf:"varint,3,opt,name=what,enum=pbx.InfoNote" json:"what,omitempty"`
-	SeqId                int32    `protobuf:"varint,4,opt,name=seq_id,json=seqId" json:"seq_id,omitempty"`
+	Topic        string   `protobuf:"bytes,1,opt,name=topic" json:"topic,omitempty"`
+	FromUserId   string   `protobuf:"bytes,2,opt,name=from_user_id,json=fromUserId" json:"from_user_id,omitempty"`
+	What         InfoNote `protobuf:"varint,3,opt,name=what,enum=pbx.InfoNote" json:"what,omitempty"`
+	SeqId        int32    `protobuf:"varint,4,opt,name=seq_id,json=seqId" json:"seq_id,omitempty"`
+	ContactId    string   `protobuf:"bytes,5,opt,name=contact_id,json=contactId" json:"contact_id,omitempty"`
+	ContactState int32    `protobuf:"varint,6,opt,name=contact_state,json=contactState" json:"contact_state,omitempty"`
+	// Emoji of the reaction
+	Emoji                string   `protobuf:"bytes,7,opt,name=emoji" json:"emoji,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2561,7 +3970,7 @@ func (m *ServerInfo) Reset()         { *m = ServerInfo{} }
 func (m *ServerInfo) String() string { return proto.CompactTextString(m) }
 func (*ServerInfo) ProtoMessage()    {}
 func (*ServerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{28}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{41}
 }
 func (m *ServerInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServerInfo.Unmarshal(m, b)
@@ -2609,6 +4018,187 @@ func (m *ServerInfo) GetSeqId() int32 {
 	return 0
 }
 
+func (m *ServerInfo) GetContactId() string {
+	if m != nil {
+		return m.ContactId
+	}
+	return ""
+}
+
+func (m *ServerInfo) GetContactState() int32 {
+	if m != nil {
+		return m.ContactState
+	}
+	return 0
+}
+
+func (m *ServerInfo) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
+// {contact} message
+type ServerContact struct {
+	What                 string   `protobuf:"bytes,1,opt,name=what" json:"what,omitempty"`
+	Sender               string   `protobuf:"bytes,2,opt,name=sender" json:"sender,omitempty"`
+	Receiver             string   `protobuf:"bytes,3,opt,name=receiver" json:"receiver,omitempty"`
+	ContactId            string   `protobuf:"bytes,4,opt,name=contact_id,json=contactId" json:"contact_id,omitempty"`
+	Message              string   `protobuf:"bytes,5,opt,name=message" json:"message,omitempty"`
+	Source               string   `protobuf:"bytes,6,opt,name=source" json:"source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServerContact) Reset()         { *m = ServerContact{} }
+func (m *ServerContact) String() string { return proto.CompactTextString(m) }
+func (*ServerContact) ProtoMessage()    {}
+func (*ServerContact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{42}
+}
+func (m *ServerContact) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServerContact.Unmarshal(m, b)
+}
+func (m *ServerContact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServerContact.Marshal(b, m, deterministic)
+}
+func (dst *ServerContact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServerContact.Merge(dst, src)
+}
+func (m *ServerContact) XXX_Size() int {
+	return xxx_messageInfo_ServerContact.Size(m)
+}
+func (m *ServerContact) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServerContact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServerContact proto.InternalMessageInfo
+
+func (m *ServerContact) GetWhat() string {
+	if m != nil {
+		return m.What
+	}
+	return ""
+}
+
+func (m *ServerContact) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ServerContact) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *ServerContact) GetContactId() string {
+	if m != nil {
+		return m.ContactId
+	}
+	return ""
+}
+
+func (m *ServerContact) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ServerContact) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+// {signal} message
+type ServerSignal struct {
+	Target  string `protobuf:"bytes,1,opt,name=target" json:"target,omitempty"`
+	Command string `protobuf:"bytes,2,opt,name=command" json:"command,omitempty"`
+	Room    string `protobuf:"bytes,3,opt,name=room" json:"room,omitempty"`
+	// Sender of a peer-to-peer signal
+	From string `protobuf:"bytes,4,opt,name=from" json:"from,omitempty"`
+	// Recipient of a peer-to-peer signal
+	User                 string   `protobuf:"bytes,5,opt,name=user" json:"user,omitempty"`
+	Payload              []byte   `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServerSignal) Reset()         { *m = ServerSignal{} }
+func (m *ServerSignal) String() string { return proto.CompactTextString(m) }
+func (*ServerSignal) ProtoMessage()    {}
+func (*ServerSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{43}
+}
+func (m *ServerSignal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServerSignal.Unmarshal(m, b)
+}
+func (m *ServerSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServerSignal.Marshal(b, m, deterministic)
+}
+func (dst *ServerSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServerSignal.Merge(dst, src)
+}
+func (m *ServerSignal) XXX_Size() int {
+	return xxx_messageInfo_ServerSignal.Size(m)
+}
+func (m *ServerSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServerSignal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServerSignal proto.InternalMessageInfo
+
+func (m *ServerSignal) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *ServerSignal) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *ServerSignal) GetRoom() string {
+	if m != nil {
+		return m.Room
+	}
+	return ""
+}
+
+func (m *ServerSignal) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ServerSignal) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *ServerSignal) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
 // Cumulative message
 type ServerMsg struct {
 	// Types that are valid to be assigned to Message:
@@ -2617,6 +4207,8 @@ type ServerMsg struct {
 	//	*ServerMsg_Pres
 	//	*ServerMsg_Meta
 	//	*ServerMsg_Info
+	//	*ServerMsg_Contact
+	//	*ServerMsg_Signal
 	Message isServerMsg_Message `protobuf_oneof:"Message"`
 	// When response is sent to Root, send internal topic name too.
 	Topic                string   `protobuf:"bytes,6,opt,name=topic" json:"topic,omitempty"`
@@ -2629,7 +4221,7 @@ func (m *ServerMsg) Reset()         { *m = ServerMsg{} }
 func (m *ServerMsg) String() string { return proto.CompactTextString(m) }
 func (*ServerMsg) ProtoMessage()    {}
 func (*ServerMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{29}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{44}
 }
 func (m *ServerMsg) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServerMsg.Unmarshal(m, b)
@@ -2668,12 +4260,20 @@ type ServerMsg_Meta struct {
 type ServerMsg_Info struct {
 	Info *ServerInfo `protobuf:"bytes,5,opt,name=info,oneof"`
 }
+type ServerMsg_Contact struct {
+	Contact *ServerContact `protobuf:"bytes,7,opt,name=contact,oneof"`
+}
+type ServerMsg_Signal struct {
+	Signal *ServerSignal `protobuf:"bytes,8,opt,name=signal,oneof"`
+}
 
-func (*ServerMsg_Ctrl) isServerMsg_Message() {}
-func (*ServerMsg_Data) isServerMsg_Message() {}
-func (*ServerMsg_Pres) isServerMsg_Message() {}
-func (*ServerMsg_Meta) isServerMsg_Message() {}
-func (*ServerMsg_Info) isServerMsg_Message() {}
+func (*ServerMsg_Ctrl) isServerMsg_Message()    {}
+func (*ServerMsg_Data) isServerMsg_Message()    {}
+func (*ServerMsg_Pres) isServerMsg_Message()    {}
+func (*ServerMsg_Meta) isServerMsg_Message()    {}
+func (*ServerMsg_Info) isServerMsg_Message()    {}
+func (*ServerMsg_Contact) isServerMsg_Message() {}
+func (*ServerMsg_Signal) isServerMsg_Message()  {}
 
 func (m *ServerMsg) GetMessage() isServerMsg_Message {
 	if m != nil {
@@ -2717,6 +4317,20 @@ func (m *ServerMsg) GetInfo() *ServerInfo {
 	return nil
 }
 
+func (m *ServerMsg) GetContact() *ServerContact {
+	if x, ok := m.GetMessage().(*ServerMsg_Contact); ok {
+		return x.Contact
+	}
+	return nil
+}
+
+func (m *ServerMsg) GetSignal() *ServerSignal {
+	if x, ok := m.GetMessage().(*ServerMsg_Signal); ok {
+		return x.Signal
+	}
+	return nil
+}
+
 func (m *ServerMsg) GetTopic() string {
 	if m != nil {
 		return m.Topic
@@ -2732,6 +4346,8 @@ func (*ServerMsg) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) err
 		(*ServerMsg_Pres)(nil),
 		(*ServerMsg_Meta)(nil),
 		(*ServerMsg_Info)(nil),
+		(*ServerMsg_Contact)(nil),
+		(*ServerMsg_Signal)(nil),
 	}
 }
 
@@ -2764,6 +4380,16 @@ func _ServerMsg_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Info); err != nil {
 			return err
 		}
+	case *ServerMsg_Contact:
+		b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Contact); err != nil {
+			return err
+		}
+	case *ServerMsg_Signal:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Signal); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ServerMsg.Message has unexpected type %T", x)
@@ -2814,6 +4440,22 @@ func _ServerMsg_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buff
 		err := b.DecodeMessage(msg)
 		m.Message = &ServerMsg_Info{msg}
 		return true, err
+	case 7: // Message.contact
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ServerContact)
+		err := b.DecodeMessage(msg)
+		m.Message = &ServerMsg_Contact{msg}
+		return true, err
+	case 8: // Message.signal
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ServerSignal)
+		err := b.DecodeMessage(msg)
+		m.Message = &ServerMsg_Signal{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -2848,6 +4490,16 @@ func _ServerMsg_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ServerMsg_Contact:
+		s := proto.Size(x.Contact)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ServerMsg_Signal:
+		s := proto.Size(x.Signal)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -2868,7 +4520,7 @@ func (m *ServerResp) Reset()         { *m = ServerResp{} }
 func (m *ServerResp) String() string { return proto.CompactTextString(m) }
 func (*ServerResp) ProtoMessage()    {}
 func (*ServerResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{30}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{45}
 }
 func (m *ServerResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServerResp.Unmarshal(m, b)
@@ -2927,7 +4579,7 @@ func (m *Session) Reset()         { *m = Session{} }
 func (m *Session) String() string { return proto.CompactTextString(m) }
 func (*Session) ProtoMessage()    {}
 func (*Session) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{31}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{46}
 }
 func (m *Session) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Session.Unmarshal(m, b)
@@ -3008,7 +4660,7 @@ func (m *ClientReq) Reset()         { *m = ClientReq{} }
 func (m *ClientReq) String() string { return proto.CompactTextString(m) }
 func (*ClientReq) ProtoMessage()    {}
 func (*ClientReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{32}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{47}
 }
 func (m *ClientReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClientReq.Unmarshal(m, b)
@@ -3054,7 +4706,7 @@ func (m *SearchQuery) Reset()         { *m = SearchQuery{} }
 func (m *SearchQuery) String() string { return proto.CompactTextString(m) }
 func (*SearchQuery) ProtoMessage()    {}
 func (*SearchQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{33}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{48}
 }
 func (m *SearchQuery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SearchQuery.Unmarshal(m, b)
@@ -3103,7 +4755,7 @@ func (m *SearchFound) Reset()         { *m = SearchFound{} }
 func (m *SearchFound) String() string { return proto.CompactTextString(m) }
 func (*SearchFound) ProtoMessage()    {}
 func (*SearchFound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{34}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{49}
 }
 func (m *SearchFound) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SearchFound.Unmarshal(m, b)
@@ -3157,7 +4809,7 @@ func (m *TopicEvent) Reset()         { *m = TopicEvent{} }
 func (m *TopicEvent) String() string { return proto.CompactTextString(m) }
 func (*TopicEvent) ProtoMessage()    {}
 func (*TopicEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{35}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{50}
 }
 func (m *TopicEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TopicEvent.Unmarshal(m, b)
@@ -3214,7 +4866,7 @@ func (m *AccountEvent) Reset()         { *m = AccountEvent{} }
 func (m *AccountEvent) String() string { return proto.CompactTextString(m) }
 func (*AccountEvent) ProtoMessage()    {}
 func (*AccountEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{36}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{51}
 }
 func (m *AccountEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountEvent.Unmarshal(m, b)
@@ -3287,7 +4939,7 @@ func (m *SubscriptionEvent) Reset()         { *m = SubscriptionEvent{} }
 func (m *SubscriptionEvent) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionEvent) ProtoMessage()    {}
 func (*SubscriptionEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{37}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{52}
 }
 func (m *SubscriptionEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubscriptionEvent.Unmarshal(m, b)
@@ -3375,7 +5027,7 @@ func (m *MessageEvent) Reset()         { *m = MessageEvent{} }
 func (m *MessageEvent) String() string { return proto.CompactTextString(m) }
 func (*MessageEvent) ProtoMessage()    {}
 func (*MessageEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_b64bd2d8b89d73ef, []int{38}
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{53}
 }
 func (m *MessageEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MessageEvent.Unmarshal(m, b)
@@ -3409,6 +5061,80 @@ func (m *MessageEvent) GetMsg() *ServerData {
 	return nil
 }
 
+type ContactEvent struct {
+	Action Crud `protobuf:"varint,1,opt,name=action,enum=pbx.Crud" json:"action,omitempty"`
+	// What happened: "add", "reject", "agree" for contact requests, "del" for contacts.
+	What string `protobuf:"bytes,2,opt,name=what" json:"what,omitempty"`
+	// User who performed the action
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+	// The other user
+	ContactId string `protobuf:"bytes,4,opt,name=contact_id,json=contactId" json:"contact_id,omitempty"`
+	// ID of the contact request, if known
+	Id                   string   `protobuf:"bytes,5,opt,name=id" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContactEvent) Reset()         { *m = ContactEvent{} }
+func (m *ContactEvent) String() string { return proto.CompactTextString(m) }
+func (*ContactEvent) ProtoMessage()    {}
+func (*ContactEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_ce6788fe4aa4277a, []int{54}
+}
+func (m *ContactEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContactEvent.Unmarshal(m, b)
+}
+func (m *ContactEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContactEvent.Marshal(b, m, deterministic)
+}
+func (dst *ContactEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactEvent.Merge(dst, src)
+}
+func (m *ContactEvent) XXX_Size() int {
+	return xxx_messageInfo_ContactEvent.Size(m)
+}
+func (m *ContactEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContactEvent proto.InternalMessageInfo
+
+func (m *ContactEvent) GetAction() Crud {
+	if m != nil {
+		return m.Action
+	}
+	return Crud_CREATE
+}
+
+func (m *ContactEvent) GetWhat() string {
+	if m != nil {
+		return m.What
+	}
+	return ""
+}
+
+func (m *ContactEvent) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ContactEvent) GetContactId() string {
+	if m != nil {
+		return m.ContactId
+	}
+	return ""
+}
+
+func (m *ContactEvent) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Unused)(nil), "pbx.Unused")
 	proto.RegisterType((*DefaultAcsMode)(nil), "pbx.DefaultAcsMode")
@@ -3418,6 +5144,9 @@ func init() {
 	proto.RegisterType((*GetOpts)(nil), "pbx.GetOpts")
 	proto.RegisterType((*GetQuery)(nil), "pbx.GetQuery")
 	proto.RegisterType((*SetQuery)(nil), "pbx.SetQuery")
+	proto.RegisterType((*SetContact)(nil), "pbx.SetContact")
+	proto.RegisterType((*SetBlock)(nil), "pbx.SetBlock")
+	proto.RegisterType((*Privacy)(nil), "pbx.Privacy")
 	proto.RegisterType((*SeqRange)(nil), "pbx.SeqRange")
 	proto.RegisterType((*Credential)(nil), "pbx.Credential")
 	proto.RegisterType((*ClientHi)(nil), "pbx.ClientHi")
@@ -3431,6 +5160,8 @@ func init() {
 	proto.RegisterType((*ClientSet)(nil), "pbx.ClientSet")
 	proto.RegisterType((*ClientDel)(nil), "pbx.ClientDel")
 	proto.RegisterType((*ClientNote)(nil), "pbx.ClientNote")
+	proto.RegisterType((*ClientContact)(nil), "pbx.ClientContact")
+	proto.RegisterType((*ClientSignal)(nil), "pbx.ClientSignal")
 	proto.RegisterType((*ClientMsg)(nil), "pbx.ClientMsg")
 	proto.RegisterType((*TopicDesc)(nil), "pbx.TopicDesc")
 	proto.RegisterType((*TopicSub)(nil), "pbx.TopicSub")
@@ -3439,9 +5170,19 @@ func init() {
 	proto.RegisterMapType((map[string][]byte)(nil), "pbx.ServerCtrl.ParamsEntry")
 	proto.RegisterType((*ServerData)(nil), "pbx.ServerData")
 	proto.RegisterMapType((map[string][]byte)(nil), "pbx.ServerData.HeadEntry")
+	proto.RegisterType((*MessageReaction)(nil), "pbx.MessageReaction")
+	proto.RegisterType((*MessageRevision)(nil), "pbx.MessageRevision")
 	proto.RegisterType((*ServerPres)(nil), "pbx.ServerPres")
+	proto.RegisterType((*ContactMsg)(nil), "pbx.ContactMsg")
+	proto.RegisterType((*Contact)(nil), "pbx.Contact")
 	proto.RegisterType((*ServerMeta)(nil), "pbx.ServerMeta")
+	proto.RegisterType((*SearchHit)(nil), "pbx.SearchHit")
+	proto.RegisterType((*Highlight)(nil), "pbx.Highlight")
+	proto.RegisterType((*IceServer)(nil), "pbx.IceServer")
+	proto.RegisterType((*CallInfo)(nil), "pbx.CallInfo")
 	proto.RegisterType((*ServerInfo)(nil), "pbx.ServerInfo")
+	proto.RegisterType((*ServerContact)(nil), "pbx.ServerContact")
+	proto.RegisterType((*ServerSignal)(nil), "pbx.ServerSignal")
 	proto.RegisterType((*ServerMsg)(nil), "pbx.ServerMsg")
 	proto.RegisterType((*ServerResp)(nil), "pbx.ServerResp")
 	proto.RegisterType((*Session)(nil), "pbx.Session")
@@ -3452,10 +5193,12 @@ func init() {
 	proto.RegisterType((*AccountEvent)(nil), "pbx.AccountEvent")
 	proto.RegisterType((*SubscriptionEvent)(nil), "pbx.SubscriptionEvent")
 	proto.RegisterType((*MessageEvent)(nil), "pbx.MessageEvent")
+	proto.RegisterType((*ContactEvent)(nil), "pbx.ContactEvent")
 	proto.RegisterEnum("pbx.AuthLevel", AuthLevel_name, AuthLevel_value)
 	proto.RegisterEnum("pbx.InfoNote", InfoNote_name, InfoNote_value)
 	proto.RegisterEnum("pbx.RespCode", RespCode_name, RespCode_value)
 	proto.RegisterEnum("pbx.Crud", Crud_name, Crud_value)
+	proto.RegisterEnum("pbx.SetContact_Star", SetContact_Star_name, SetContact_Star_value)
 	proto.RegisterEnum("pbx.ClientDel_What", ClientDel_What_name, ClientDel_What_value)
 	proto.RegisterEnum("pbx.ServerPres_What", ServerPres_What_name, ServerPres_What_value)
 }
@@ -3586,6 +5329,8 @@ type PluginClient interface {
 	Subscription(ctx context.Context, in *SubscriptionEvent, opts ...grpc.CallOption) (*Unused, error)
 	// Message published or deleted
 	Message(ctx context.Context, in *MessageEvent, opts ...grpc.CallOption) (*Unused, error)
+	// Contact request created, rejected or accepted, contact deleted
+	Contact(ctx context.Context, in *ContactEvent, opts ...grpc.CallOption) (*Unused, error)
 }
 
 type pluginClient struct {
@@ -3650,6 +5395,15 @@ func (c *pluginClient) Message(ctx context.Context, in *MessageEvent, opts ...gr
 	return out, nil
 }
 
+func (c *pluginClient) Contact(ctx context.Context, in *ContactEvent, opts ...grpc.CallOption) (*Unused, error) {
+	out := new(Unused)
+	err := grpc.Invoke(ctx, "/pbx.Plugin/Contact", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Plugin service
 
 type PluginServer interface {
@@ -3670,6 +5424,8 @@ type PluginServer interface {
 	Subscription(context.Context, *SubscriptionEvent) (*Unused, error)
 	// Message published or deleted
 	Message(context.Context, *MessageEvent) (*Unused, error)
+	// Contact request created, rejected or accepted, contact deleted
+	Contact(context.Context, *ContactEvent) (*Unused, error)
 }
 
 func RegisterPluginServer(s *grpc.Server, srv PluginServer) {
@@ -3784,6 +5540,24 @@ func _Plugin_Message_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Plugin_Contact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).Contact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pbx.Plugin/Contact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).Contact(ctx, req.(*ContactEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Plugin_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pbx.Plugin",
 	HandlerType: (*PluginServer)(nil),
@@ -3812,171 +5586,255 @@ var _Plugin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Message",
 			Handler:    _Plugin_Message_Handler,
 		},
+		{
+			MethodName: "Contact",
+			Handler:    _Plugin_Contact_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "model.proto",
 }
 
-func init() { proto.RegisterFile("model.proto", fileDescriptor_model_b64bd2d8b89d73ef) }
-
-var fileDescriptor_model_b64bd2d8b89d73ef = []byte{
-	// 2518 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x19, 0x5d, 0x93, 0xe3, 0x46,
-	0xd1, 0xb2, 0x6c, 0x59, 0x6a, 0x3b, 0xbb, 0xba, 0xe1, 0x48, 0x9c, 0x0d, 0x49, 0xf6, 0x94, 0xaf,
-	0xab, 0x4d, 0xee, 0xa0, 0xee, 0x08, 0x04, 0xc8, 0x8b, 0xb3, 0xf6, 0xed, 0x2e, 0xdc, 0xae, 0x8d,
-	0xbc, 0x1b, 0x5e, 0xa8, 0x72, 0xc9, 0xd2, 0xd8, 0x56, 0x45, 0x96, 0xbc, 0xd2, 0xc8, 0x49, 0x5e,
-	0xa8, 0x82, 0x37, 0xfe, 0x01, 0xc5, 0x0b, 0x7f, 0x80, 0xfc, 0x01, 0x7e, 0x42, 0xf8, 0x09, 0x54,
-	0xf1, 0x4e, 0x51, 0x14, 0x7f, 0x81, 0xea, 0xf9, 0x90, 0x25, 0xef, 0xfa, 0xb2, 0x01, 0xde, 0x66,
-	0xba, 0x5b, 0x3d, 0xfd, 0xdd, 0x3d, 0x23, 0x68, 0x2f, 0x93, 0x80, 0x46, 0x8f, 0x57, 0x69, 0xc2,
-	0x12, 0xa2, 0xaf, 0xa6, 0x5f, 0x38, 0x26, 0x18, 0x57, 0x71, 0x9e, 0xd1, 0xc0, 0xf9, 0x08, 0xf6,
-	0xfa, 0x74, 0xe6, 0xe5, 0x11, 0xeb, 0xf9, 0xd9, 0x79, 0x12, 0x50, 0x42, 0xa0, 0xe1, 0xe5, 0x6c,
-	0xd1, 0xd5, 0x0e, 0xb5, 0x87, 0x96, 0xcb, 0xd7, 0x1c, 0x16, 0x27, 0x71, 0xb7, 0x2e, 0x61, 0x71,
-	0x12, 0x3b, 0x3f, 0x02, 0xe8, 0xf9, 0x3e, 0xcd, 0x8a, 0xaf, 0x3e, 0xf7, 0x62, 0xa6, 0xbe, 0xc2,
-	0x35, 0xb9, 0x0f, 0xcd, 0x79, 0xb8, 0xa6, 0xea, 0x33, 0xb1, 0x71, 0x3e, 0x04, 0x63, 0x4c, 0xd9,
-	0x38, 0x9f, 0x92, 0x57, 0xa0, 0x95, 0x67, 0x34, 0x9d, 0x84, 0x81, 0xfc, 0xcc, 0xc0, 0xed, 0x59,
-	0x80, 0xcc, 0x50, 0x64, 0x75, 0x1c, 0xae, 0x9d, 0x6b, 0x68, 0x8d, 0x29, 0xeb, 0xd3, 0xcc, 0x27,
-	0x3f, 0x84, 0x76, 0x20, 0x64, 0x9e, 0x78, 0x7e, 0xc6, 0xbf, 0x6d, 0x3f, 0xf9, 0xce, 0xe3, 0xd5,
-	0xf4, 0x8b, 0xc7, 0x55, 0x5d, 0x5c, 0x08, 0x8a, 0x3d, 0x79, 0x19, 0x8c, 0x55, 0x3e, 0x8d, 0x42,
-	0x9f, 0xb3, 0xed, 0xb8, 0x72, 0x47, 0xba, 0xd0, 0x5a, 0xa5, 0xe1, 0xda, 0x63, 0xb4, 0xab, 0x73,
-	0x84, 0xda, 0x3a, 0x5f, 0x69, 0xd0, 0x3a, 0xa1, 0x6c, 0xb8, 0x62, 0x19, 0x39, 0x82, 0x7b, 0xe1,
-	0x6c, 0xb2, 0x4c, 0x82, 0x70, 0x16, 0xd2, 0x60, 0x92, 0x85, 0xb1, 0x4f, 0xf9, 0xc9, 0xba, 0xbb,
-	0x1f, 0xce, 0xce, 0x25, 0x7c, 0x8c, 0x60, 0x14, 0x1f, 0x15, 0x51, 0xe2, 0xe3, 0x1a, 0x6d, 0xc1,
-	0x92, 0x55, 0xe8, 0xf3, 0x33, 0x2c, 0x57, 0x6c, 0xc8, 0xab, 0x60, 0x72, 0x4e, 0x68, 0x82, 0xc6,
-	0xa1, 0xf6, 0xb0, 0xe9, 0xb6, 0xf8, 0xfe, 0x2c, 0x20, 0xaf, 0x81, 0x35, 0xa5, 0xb3, 0x24, 0xe5,
-	0xb8, 0x26, 0xc7, 0x99, 0x02, 0x70, 0x16, 0x20, 0xb7, 0x28, 0x5c, 0x86, 0xac, 0x6b, 0x70, 0x84,
-	0xd8, 0x38, 0xbf, 0xd3, 0xc0, 0x3c, 0xa1, 0xec, 0x97, 0x39, 0x4d, 0xbf, 0xe4, 0x0e, 0x59, 0x78,
-	0x1b, 0x87, 0x2c, 0x3c, 0x46, 0x0e, 0xa1, 0x11, 0xd0, 0x4c, 0x18, 0xa0, 0xfd, 0xa4, 0xc3, 0x2d,
-	0x26, 0x15, 0x74, 0x39, 0x86, 0xbc, 0x01, 0x7a, 0x96, 0x4f, 0xb9, 0x90, 0xdb, 0x04, 0x88, 0xe0,
-	0x1c, 0x3c, 0xe6, 0x71, 0x61, 0x6f, 0x72, 0xf0, 0x98, 0xe7, 0x4c, 0xc0, 0x1c, 0x2b, 0x19, 0xd4,
-	0x79, 0x5a, 0x89, 0x5a, 0x3a, 0x51, 0x9e, 0xf7, 0xba, 0x38, 0x4f, 0x08, 0xd4, 0x56, 0x04, 0xe3,
-	0x7c, 0x2a, 0x8e, 0x23, 0xd0, 0x60, 0xde, 0x3c, 0xeb, 0xea, 0x87, 0x3a, 0x2a, 0x81, 0x6b, 0xe7,
-	0x03, 0x3c, 0xe0, 0xda, 0xf5, 0xe2, 0x39, 0x25, 0x36, 0xe8, 0x51, 0xf2, 0x39, 0xe7, 0xdf, 0x74,
-	0x71, 0x49, 0xf6, 0xa0, 0xbe, 0x08, 0x39, 0xbf, 0xa6, 0x5b, 0x5f, 0x84, 0x4e, 0x0c, 0x70, 0x9c,
-	0xd2, 0x80, 0xc6, 0x2c, 0xf4, 0x22, 0x8c, 0x81, 0x25, 0x65, 0x8b, 0xa4, 0x08, 0x38, 0xb1, 0x43,
-	0x7b, 0xae, 0xbd, 0x28, 0x57, 0x11, 0x27, 0x36, 0xe4, 0x00, 0xcc, 0x94, 0x66, 0xab, 0x24, 0xce,
-	0xa8, 0x74, 0x5b, 0xb1, 0xe7, 0xd1, 0xe4, 0xa5, 0xde, 0x32, 0xe3, 0xa6, 0xc0, 0x68, 0xe2, 0x3b,
-	0xe7, 0x0f, 0x1a, 0x98, 0xc7, 0x51, 0x48, 0x63, 0x76, 0x1a, 0xa2, 0x30, 0x45, 0x6c, 0xd7, 0xc3,
-	0x80, 0xbc, 0x0e, 0xc0, 0x03, 0xde, 0x9b, 0xd3, 0x98, 0xc9, 0xb3, 0x2c, 0x84, 0xf4, 0x10, 0x80,
-	0xda, 0xac, 0x69, 0x2a, 0x8f, 0xc2, 0x25, 0x06, 0x41, 0x40, 0xd7, 0xe1, 0x26, 0x40, 0x2c, 0xd7,
-	0x14, 0x00, 0x91, 0x25, 0x91, 0x17, 0xcf, 0x79, 0x70, 0x58, 0x2e, 0x5f, 0xa3, 0xc8, 0xab, 0xc8,
-	0x63, 0xb3, 0x24, 0x5d, 0xf2, 0xd8, 0xb0, 0xdc, 0x62, 0xef, 0xfc, 0x53, 0x03, 0x4b, 0x88, 0xd6,
-	0xf3, 0xfd, 0x1b, 0xb2, 0x95, 0x92, 0xb1, 0x5e, 0x49, 0xc6, 0x97, 0xc1, 0xc8, 0xfc, 0x05, 0x5d,
-	0x2a, 0x1b, 0xc8, 0x1d, 0x87, 0x53, 0x3f, 0xa5, 0x4c, 0x59, 0x40, 0xec, 0x78, 0x6c, 0x26, 0xf3,
-	0x30, 0xe6, 0x72, 0x99, 0xae, 0xd8, 0x14, 0x9e, 0x34, 0x36, 0x9e, 0x2c, 0xc2, 0xa3, 0xb5, 0x33,
-	0x3c, 0xde, 0x82, 0x86, 0x9f, 0xd2, 0xa0, 0x6b, 0x1e, 0xea, 0x0f, 0xdb, 0x4f, 0xf6, 0x39, 0xc5,
-	0xc6, 0x9d, 0x2e, 0x47, 0x8a, 0xd4, 0xfa, 0x8c, 0xc6, 0x5d, 0x8b, 0xcb, 0x21, 0x36, 0x4e, 0x0a,
-	0x6d, 0xa1, 0xec, 0x73, 0x7e, 0xfe, 0xb6, 0xba, 0x1b, 0xad, 0xea, 0x3b, 0xb4, 0xd2, 0x2b, 0x5a,
-	0x29, 0x49, 0x1a, 0x2f, 0x90, 0xc4, 0xf9, 0x7d, 0x61, 0x61, 0x2c, 0x6f, 0xdb, 0x47, 0x16, 0x25,
-	0xa0, 0x5e, 0x2e, 0x01, 0x47, 0x60, 0x65, 0x94, 0x4d, 0xae, 0x31, 0x61, 0x64, 0xde, 0xbd, 0xa4,
-	0x2c, 0xc1, 0xb3, 0xc8, 0x35, 0x33, 0x95, 0x4f, 0x47, 0x60, 0xcd, 0x0b, 0xda, 0x46, 0x89, 0xf6,
-	0xa4, 0xa0, 0x9d, 0xcb, 0x95, 0x73, 0x56, 0xe8, 0x4f, 0xbd, 0x35, 0xbd, 0xa3, 0x30, 0xf7, 0xa1,
-	0x99, 0xc7, 0xaa, 0x00, 0x98, 0xae, 0xd8, 0x38, 0x7f, 0x2d, 0xd4, 0x1a, 0xdd, 0x59, 0xad, 0x57,
-	0xa0, 0x15, 0x27, 0x13, 0xea, 0x2f, 0x12, 0xc9, 0xcb, 0x88, 0x93, 0x81, 0xbf, 0x48, 0xc8, 0x07,
-	0xd0, 0x58, 0x50, 0x4f, 0x19, 0xb2, 0x2b, 0x0c, 0xa9, 0x98, 0x3f, 0x3e, 0xa5, 0x5e, 0x30, 0x88,
-	0x59, 0xfa, 0xa5, 0xcb, 0xa9, 0xb0, 0x38, 0xfb, 0x49, 0xcc, 0x30, 0x5d, 0x9a, 0xa2, 0x38, 0xcb,
-	0xed, 0xc1, 0x8f, 0xc1, 0x2a, 0x88, 0x31, 0x73, 0x3e, 0xa3, 0x5f, 0x4a, 0xa1, 0x70, 0x59, 0xcd,
-	0xe8, 0x8e, 0xcc, 0xe8, 0x9f, 0xd6, 0x3f, 0xd2, 0x9c, 0x4f, 0x95, 0x32, 0x27, 0x94, 0xdd, 0x51,
-	0x99, 0xb7, 0xa0, 0x79, 0xd3, 0x3f, 0x85, 0xcd, 0x05, 0x6e, 0xc3, 0x77, 0xfc, 0xbf, 0xf1, 0x1d,
-	0x6f, 0xf1, 0xfd, 0x7b, 0x61, 0xfd, 0x3e, 0x8d, 0xee, 0xc8, 0xf8, 0x3d, 0x59, 0xfc, 0x91, 0xef,
-	0x9e, 0x6c, 0x8d, 0x05, 0x8f, 0xc7, 0xbf, 0x5a, 0x78, 0x4c, 0x76, 0x84, 0x77, 0xa1, 0x15, 0xd0,
-	0x68, 0x92, 0xd1, 0x6b, 0xe9, 0x10, 0x25, 0x83, 0x28, 0xb0, 0xae, 0x11, 0xd0, 0x68, 0x4c, 0xaf,
-	0xcb, 0xd5, 0xa1, 0xb9, 0xdd, 0xaa, 0x17, 0x5e, 0x1a, 0xf0, 0x62, 0x63, 0xba, 0x7c, 0xed, 0x3c,
-	0x82, 0x06, 0x1e, 0x41, 0x5a, 0xa0, 0x9f, 0x8f, 0x4f, 0xec, 0x1a, 0xb1, 0xa0, 0x79, 0x39, 0x1c,
-	0x9d, 0x1d, 0xdb, 0x1a, 0xc2, 0xc6, 0x57, 0x9f, 0xd8, 0x75, 0x62, 0x42, 0xe3, 0x6a, 0x3c, 0x70,
-	0x6d, 0xdd, 0xf9, 0x35, 0x80, 0x90, 0xed, 0x22, 0x61, 0x74, 0xa3, 0x90, 0x56, 0x56, 0xe8, 0x81,
-	0x54, 0xa8, 0xce, 0x15, 0x12, 0x42, 0x9e, 0xc5, 0xb3, 0x04, 0x3f, 0x91, 0xaa, 0x7c, 0x17, 0x33,
-	0xf7, 0x1a, 0x25, 0xd4, 0x45, 0x53, 0xcc, 0xe8, 0xf5, 0x59, 0xe0, 0xfc, 0x4d, 0x57, 0xe6, 0x3b,
-	0xcf, 0xe6, 0xe4, 0x4d, 0xde, 0x1e, 0xb4, 0x92, 0xb9, 0x55, 0xb1, 0x3e, 0xad, 0x61, 0xbf, 0x20,
-	0x0e, 0xe8, 0x9e, 0xaf, 0x3a, 0xe4, 0x5e, 0x89, 0xa2, 0xe7, 0xfb, 0xa7, 0x35, 0x17, 0x91, 0xe4,
-	0xa1, 0xaa, 0x70, 0xc2, 0x6d, 0x76, 0x89, 0x8a, 0x17, 0x9b, 0xd3, 0x9a, 0xaa, 0x7a, 0x8e, 0x68,
-	0x6f, 0x8d, 0x1b, 0xdc, 0xc6, 0xf9, 0x14, 0xb9, 0x61, 0x8f, 0x43, 0x6e, 0x98, 0xa2, 0xdc, 0xb0,
-	0x5b, 0xdc, 0x10, 0xce, 0xb9, 0xf1, 0x1c, 0x76, 0x40, 0x5f, 0xe5, 0x53, 0x6e, 0xea, 0x2a, 0xb7,
-	0x91, 0xe0, 0xb6, 0xca, 0xa7, 0x48, 0x33, 0xa7, 0x4c, 0x96, 0xd4, 0x32, 0xcd, 0x09, 0x65, 0x48,
-	0x33, 0xa7, 0x8c, 0x4b, 0x45, 0x59, 0xd7, 0xbc, 0x29, 0x95, 0xa0, 0xc9, 0x04, 0x4d, 0x40, 0x23,
-	0x5e, 0x52, 0xab, 0x34, 0x7d, 0x1a, 0x21, 0x4d, 0x40, 0x23, 0xf2, 0x0e, 0x34, 0xe2, 0x84, 0xd1,
-	0x2e, 0x70, 0xa2, 0xfd, 0x12, 0x11, 0xba, 0xe5, 0xb4, 0xe6, 0x72, 0x34, 0x39, 0x84, 0x4e, 0x12,
-	0x4f, 0xa6, 0x74, 0xe1, 0x45, 0xb3, 0x49, 0x32, 0xeb, 0xb6, 0xb9, 0x63, 0x21, 0x89, 0x3f, 0xe1,
-	0xa0, 0xe1, 0x8c, 0x3c, 0x02, 0xc0, 0x31, 0x73, 0x12, 0xd1, 0x35, 0x8d, 0xba, 0x1d, 0xee, 0x63,
-	0x71, 0x66, 0x2f, 0x67, 0x8b, 0xe7, 0x08, 0x75, 0x2d, 0x4f, 0x2d, 0x3f, 0xb1, 0xa0, 0x75, 0x4e,
-	0xb3, 0xcc, 0x9b, 0x53, 0xe7, 0xeb, 0x3a, 0x58, 0x97, 0x18, 0x21, 0x7d, 0x31, 0x4d, 0x80, 0x9f,
-	0x52, 0x8f, 0xd1, 0x60, 0x22, 0x27, 0x1f, 0xdd, 0xb5, 0x24, 0xa4, 0xc7, 0x78, 0xfb, 0x5d, 0x05,
-	0x0a, 0x5d, 0x17, 0x68, 0x09, 0x11, 0x68, 0x96, 0xe4, 0xfe, 0x42, 0xa0, 0x75, 0x81, 0x96, 0x90,
-	0x1e, 0x23, 0xef, 0x83, 0x81, 0xd3, 0xa4, 0x9f, 0x49, 0x77, 0xde, 0x3a, 0x70, 0x4a, 0x12, 0xf2,
-	0x00, 0xc3, 0x28, 0x93, 0x2e, 0x15, 0x96, 0xd9, 0x0c, 0xcb, 0x18, 0x45, 0x59, 0x29, 0x5e, 0x8d,
-	0x52, 0xbc, 0x62, 0xa6, 0xa5, 0xd4, 0x0b, 0x10, 0xde, 0xe2, 0x70, 0x03, 0xb7, 0x0a, 0xe1, 0xaf,
-	0x11, 0x61, 0x2a, 0x84, 0xbf, 0x3e, 0x0b, 0x90, 0x11, 0xe6, 0x70, 0x18, 0x70, 0x6f, 0x35, 0xdd,
-	0x66, 0x40, 0x23, 0xd1, 0xb7, 0xe5, 0xbc, 0x0b, 0xbb, 0xe6, 0xdd, 0x76, 0x75, 0xde, 0xfd, 0x8b,
-	0x0e, 0x26, 0x37, 0x26, 0x76, 0xaf, 0xaa, 0xb1, 0xb4, 0x5b, 0x8c, 0x15, 0xd0, 0x88, 0x56, 0x6d,
-	0x29, 0x21, 0x3d, 0x86, 0x87, 0x27, 0x71, 0x14, 0xc6, 0x54, 0x55, 0x7f, 0xb1, 0x53, 0x76, 0x69,
-	0xbc, 0xc0, 0x2e, 0x25, 0x03, 0x34, 0x77, 0x19, 0xc0, 0xa8, 0x18, 0x60, 0xa3, 0x69, 0x6b, 0x97,
-	0xa6, 0x66, 0x45, 0xd3, 0x72, 0x39, 0xb3, 0x2a, 0xe5, 0xac, 0xa8, 0x3e, 0x50, 0xae, 0x3e, 0xd5,
-	0xc8, 0x68, 0x6f, 0x47, 0xc6, 0xc6, 0x93, 0x9d, 0xb2, 0x27, 0x37, 0x7e, 0x79, 0xa9, 0xec, 0x97,
-	0xb7, 0x61, 0x2f, 0xf2, 0x32, 0x36, 0xc9, 0x28, 0x8d, 0x27, 0x2c, 0x5c, 0xd2, 0xee, 0x1e, 0x67,
-	0xd8, 0x41, 0xe8, 0x98, 0xd2, 0xf8, 0x32, 0x5c, 0x52, 0xf2, 0x7d, 0xb8, 0xbf, 0xa1, 0x2a, 0x0d,
-	0x8d, 0xfb, 0x5c, 0xae, 0x7b, 0x8a, 0xf6, 0x4a, 0x0d, 0x8f, 0xce, 0xcf, 0xc1, 0xea, 0xd3, 0xe8,
-	0x53, 0x6c, 0x73, 0x59, 0xe9, 0x68, 0xad, 0x7c, 0x74, 0xa9, 0xda, 0xd7, 0x5f, 0x50, 0xed, 0x9d,
-	0xaf, 0x35, 0x80, 0x31, 0x4d, 0xd7, 0x34, 0x3d, 0x66, 0xe9, 0x5d, 0x7b, 0x0e, 0x81, 0x86, 0x8f,
-	0x97, 0x36, 0x51, 0x7d, 0xf9, 0x9a, 0x4f, 0x7d, 0xf4, 0x0b, 0x26, 0x47, 0x57, 0xbe, 0x26, 0x4f,
-	0x8b, 0xc9, 0xb9, 0xc9, 0x65, 0x78, 0x4d, 0xca, 0xa0, 0x8e, 0x7b, 0x3c, 0xe2, 0x58, 0x31, 0x05,
-	0x48, 0xd2, 0x83, 0x9f, 0x40, 0xbb, 0x04, 0xfe, 0x56, 0xfd, 0xfe, 0x8f, 0x75, 0xa5, 0x4c, 0xdf,
-	0x63, 0xde, 0x8e, 0xfe, 0x72, 0x08, 0x9d, 0x59, 0x9a, 0x2c, 0x27, 0xd5, 0x11, 0x18, 0x10, 0x76,
-	0x25, 0x22, 0xe3, 0x7b, 0x60, 0xa1, 0xb3, 0x32, 0xe6, 0x2d, 0x57, 0x3c, 0xce, 0x30, 0x04, 0x14,
-	0x60, 0x2b, 0x1d, 0xf4, 0xed, 0x74, 0xd8, 0x44, 0x48, 0xa3, 0x1c, 0x21, 0x8f, 0xe4, 0x2c, 0x24,
-	0x0c, 0xf1, 0x6a, 0xc9, 0x10, 0x28, 0xea, 0x8b, 0x86, 0x21, 0xe3, 0xff, 0x34, 0x0c, 0x7d, 0xa5,
-	0x2b, 0xe3, 0x8c, 0x52, 0x9a, 0xed, 0x30, 0x8e, 0x0d, 0x7a, 0x96, 0x2a, 0x6f, 0xe3, 0x92, 0x3c,
-	0xac, 0xcc, 0x17, 0xf7, 0x4b, 0x82, 0x23, 0x9b, 0xf2, 0x80, 0x51, 0xbd, 0xf2, 0x34, 0xb6, 0xaf,
-	0x3c, 0x1b, 0xc3, 0x34, 0x6f, 0x4f, 0x1d, 0x63, 0x47, 0xfc, 0xb6, 0x5e, 0x34, 0xad, 0xbc, 0x0d,
-	0x7b, 0xcc, 0x4b, 0x71, 0x54, 0x56, 0xfe, 0x34, 0xf9, 0xc1, 0x1d, 0x01, 0x95, 0x1e, 0x75, 0xe0,
-	0x25, 0xcf, 0x67, 0x49, 0x3a, 0xa9, 0x96, 0x82, 0x36, 0x07, 0x4a, 0x1a, 0x59, 0xaf, 0x60, 0x77,
-	0xbd, 0x72, 0x3e, 0x93, 0xd3, 0x8e, 0x01, 0xf5, 0xe1, 0x85, 0x5d, 0xc3, 0x09, 0x67, 0xf8, 0xec,
-	0x99, 0xad, 0x21, 0xe0, 0xaa, 0x67, 0xeb, 0x08, 0xb8, 0x1a, 0xf5, 0xed, 0x06, 0x8e, 0x3c, 0x27,
-	0xc3, 0x8b, 0x81, 0xdd, 0x44, 0x50, 0xef, 0x78, 0x6c, 0x1b, 0x08, 0xba, 0x1c, 0xb8, 0xe7, 0x76,
-	0x4b, 0x0d, 0x4b, 0x26, 0x82, 0xdc, 0x41, 0xaf, 0x6f, 0x5b, 0x62, 0x75, 0xfc, 0xa9, 0x0d, 0x88,
-	0xec, 0x0f, 0x9e, 0xdb, 0x6d, 0xe7, 0xab, 0x22, 0x33, 0xcf, 0x29, 0xf3, 0xee, 0x98, 0x99, 0x8e,
-	0xbc, 0x67, 0xe9, 0xa5, 0x66, 0x5e, 0x34, 0x4d, 0x79, 0xd3, 0x7a, 0x53, 0x4d, 0x2a, 0x1b, 0xb3,
-	0xaa, 0x56, 0xa0, 0x6e, 0xfe, 0x7c, 0x20, 0x68, 0x96, 0x78, 0x14, 0xf5, 0x46, 0x8c, 0x03, 0xb7,
-	0x5c, 0xf1, 0x9c, 0xdf, 0x28, 0x71, 0x71, 0x58, 0xfb, 0xaf, 0x73, 0xef, 0x41, 0x25, 0xdc, 0xbe,
-	0x61, 0xfa, 0x2b, 0x67, 0x98, 0xf3, 0x6f, 0x0d, 0x2c, 0x69, 0xaf, 0x6c, 0x8e, 0x03, 0x8b, 0xcf,
-	0xd2, 0x48, 0xce, 0x7f, 0xfb, 0x5b, 0x85, 0x07, 0x07, 0x16, 0x44, 0x23, 0x19, 0x7f, 0xe4, 0xa8,
-	0xdf, 0x20, 0xc3, 0xb4, 0x44, 0x32, 0x44, 0x23, 0xd9, 0x2a, 0xa5, 0x99, 0x34, 0xeb, 0xfe, 0x56,
-	0x12, 0x20, 0x19, 0xa2, 0x91, 0x6c, 0x49, 0x8b, 0x27, 0x93, 0x32, 0x19, 0xba, 0x10, 0xc9, 0x10,
-	0x8d, 0x64, 0x61, 0x3c, 0x4b, 0x2a, 0x23, 0xc3, 0xc6, 0x74, 0x48, 0x16, 0x56, 0x4c, 0x68, 0x94,
-	0x4c, 0x58, 0x9e, 0x88, 0x7e, 0x5b, 0x44, 0x88, 0x4b, 0xb3, 0x15, 0x79, 0x07, 0x8c, 0x8c, 0x79,
-	0x2c, 0x17, 0xcf, 0x64, 0xca, 0x78, 0x88, 0x3a, 0xe6, 0xf3, 0x8a, 0x40, 0x92, 0x77, 0xc1, 0xc8,
-	0xd2, 0xf5, 0x32, 0x9b, 0x57, 0x26, 0xdf, 0xc2, 0x72, 0xae, 0xc4, 0x92, 0xb7, 0xa1, 0xe9, 0x47,
-	0x48, 0xa6, 0xdf, 0x18, 0x0c, 0x91, 0x4c, 0x20, 0x9d, 0x7f, 0x68, 0xd0, 0x1a, 0xd3, 0x2c, 0x0b,
-	0x93, 0x18, 0x0b, 0x40, 0x26, 0x96, 0x9b, 0x77, 0x3e, 0x4b, 0x42, 0xce, 0x5e, 0xf0, 0xec, 0x50,
-	0x9d, 0x09, 0xf5, 0x6f, 0x98, 0x09, 0xc9, 0x9b, 0xd0, 0x4e, 0xe9, 0x32, 0x61, 0x74, 0xe2, 0x05,
-	0x41, 0x2a, 0x0b, 0x0d, 0x08, 0x50, 0x2f, 0x08, 0xd2, 0xad, 0x42, 0xd4, 0xdc, 0x2e, 0x44, 0x95,
-	0x97, 0x16, 0x63, 0xeb, 0xa5, 0xe5, 0x00, 0xcc, 0xc8, 0x8b, 0xe7, 0xb9, 0x37, 0xa7, 0xbc, 0xf4,
-	0x5b, 0x6e, 0xb1, 0x77, 0x86, 0xea, 0x7a, 0xe1, 0xd2, 0x6b, 0x4c, 0x12, 0x34, 0x8e, 0x76, 0xab,
-	0x71, 0x10, 0x45, 0x0e, 0xa1, 0x81, 0xca, 0x57, 0x9e, 0xe0, 0xa4, 0xa9, 0x5c, 0x8e, 0x71, 0x3e,
-	0x86, 0xf6, 0x98, 0x7a, 0xa9, 0xbf, 0x10, 0x77, 0xfe, 0x9d, 0x8f, 0xa4, 0xf7, 0xd5, 0xe5, 0x51,
-	0xe6, 0xba, 0xb8, 0x2d, 0x5e, 0xab, 0xaf, 0x9f, 0x25, 0x79, 0x1c, 0xdc, 0xd5, 0xfd, 0xb7, 0xf2,
-	0xc2, 0x8f, 0x53, 0x9a, 0xe5, 0x11, 0xe3, 0xef, 0x6f, 0x37, 0xca, 0x82, 0x44, 0x3a, 0x73, 0x00,
-	0x0e, 0x1b, 0xac, 0xd1, 0x90, 0x0f, 0xc0, 0xf0, 0x7c, 0x16, 0x26, 0xb1, 0x3c, 0xd1, 0x92, 0x4f,
-	0x25, 0x79, 0xe0, 0x4a, 0x04, 0x16, 0x8a, 0xd8, 0x2b, 0x5e, 0x5e, 0xf8, 0xfa, 0x2e, 0x35, 0xca,
-	0xf9, 0xb3, 0x06, 0x9d, 0x9e, 0xef, 0x27, 0x79, 0xcc, 0xee, 0x7c, 0xd6, 0xce, 0xf8, 0xda, 0x7a,
-	0x44, 0xd6, 0xbf, 0xed, 0x23, 0x72, 0xa3, 0x32, 0x6a, 0xaa, 0xda, 0x67, 0x96, 0x6a, 0xdf, 0xbf,
-	0x34, 0xb8, 0x37, 0xce, 0xa7, 0x99, 0x9f, 0x86, 0x2b, 0x94, 0xe5, 0xce, 0x32, 0xef, 0x7c, 0x51,
-	0x51, 0x9a, 0xe8, 0x15, 0x4d, 0x36, 0xcd, 0xb2, 0x51, 0x6e, 0x96, 0xdf, 0x7e, 0x8e, 0x7e, 0x4b,
-	0x3e, 0xbb, 0xb7, 0x6e, 0xef, 0x76, 0x1c, 0xb9, 0x7b, 0xa8, 0x76, 0x2e, 0xa1, 0x23, 0x8b, 0xd0,
-	0x9d, 0x35, 0x7d, 0x20, 0xf2, 0xe5, 0xf6, 0x42, 0xcb, 0x13, 0xe6, 0xe8, 0x29, 0x58, 0x45, 0xc2,
-	0x63, 0x47, 0xbc, 0xc0, 0x0e, 0x5a, 0xc3, 0x55, 0xef, 0x62, 0x78, 0x61, 0x03, 0x5f, 0x5d, 0x5d,
-	0x9e, 0xda, 0xf7, 0x79, 0xbf, 0x1c, 0x0e, 0x2f, 0xed, 0x37, 0x8e, 0xde, 0x05, 0x53, 0xf5, 0x87,
-	0xa2, 0x9f, 0xd6, 0x8a, 0x7e, 0xca, 0x5b, 0xf3, 0x2f, 0x46, 0x76, 0xfd, 0xe8, 0x63, 0x30, 0x55,
-	0x2e, 0x90, 0x0e, 0x98, 0xc7, 0xc3, 0x8b, 0xcb, 0xb3, 0x8b, 0x2b, 0xc9, 0xbf, 0xef, 0x0e, 0x47,
-	0xb6, 0x46, 0xda, 0xd0, 0x72, 0x07, 0xe3, 0xd1, 0xf0, 0xa2, 0x6f, 0xd7, 0xc5, 0x66, 0xf4, 0xbc,
-	0x77, 0x3c, 0xb0, 0xf5, 0xa3, 0x23, 0x68, 0xa0, 0x36, 0x04, 0xc0, 0x38, 0x76, 0x07, 0xbd, 0x4b,
-	0xfc, 0x0e, 0xc0, 0xb8, 0x1a, 0xf5, 0x71, 0xad, 0xe1, 0xba, 0x3f, 0x78, 0x3e, 0xb8, 0x1c, 0xd8,
-	0xf5, 0x27, 0x3f, 0x83, 0xc6, 0x05, 0x9e, 0xf2, 0x14, 0xda, 0xd2, 0x48, 0xcf, 0x93, 0x64, 0x45,
-	0xb6, 0x6a, 0xc4, 0xc1, 0x56, 0xdd, 0x75, 0x6a, 0x0f, 0xb5, 0x1f, 0x68, 0x4f, 0xfe, 0x54, 0x07,
-	0x63, 0x14, 0xe5, 0xf3, 0x30, 0x26, 0x8f, 0xc0, 0x7c, 0x16, 0xa6, 0xf4, 0x34, 0xc9, 0x68, 0xe5,
-	0x63, 0x97, 0x5e, 0x1f, 0x94, 0x0d, 0x88, 0x6a, 0x39, 0x35, 0xf2, 0x01, 0x34, 0x9e, 0x85, 0x71,
-	0x40, 0x6c, 0x89, 0x2a, 0xea, 0xca, 0x41, 0x19, 0xc2, 0x6b, 0x85, 0x53, 0x23, 0xef, 0x43, 0x4b,
-	0xe6, 0x17, 0xb9, 0xa7, 0xbc, 0x5f, 0x64, 0xdb, 0x81, 0x78, 0x9e, 0x97, 0xff, 0x8d, 0x6a, 0xe4,
-	0x3d, 0x68, 0xf2, 0x04, 0x25, 0xfb, 0x9b, 0x64, 0xbd, 0x95, 0xf0, 0x43, 0xe8, 0x94, 0xd3, 0x80,
-	0xbc, 0x2c, 0x4e, 0xde, 0xce, 0x8c, 0xed, 0xcf, 0xde, 0x2f, 0x7a, 0x9a, 0x14, 0xa6, 0x1c, 0x5c,
-	0x5b, 0xc4, 0x53, 0x83, 0xff, 0xdc, 0x7a, 0xfa, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x83, 0xe9,
-	0x27, 0x53, 0xeb, 0x1a, 0x00, 0x00,
+func init() { proto.RegisterFile("model.proto", fileDescriptor_model_ce6788fe4aa4277a) }
+
+var fileDescriptor_model_ce6788fe4aa4277a = []byte{
+	// 3805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x3a, 0x4d, 0x8f, 0xe3, 0xd8,
+	0x56, 0xe5, 0x38, 0x76, 0xec, 0x93, 0x54, 0x95, 0xdb, 0xaf, 0x99, 0xc9, 0xab, 0x61, 0x66, 0xaa,
+	0xdd, 0x3d, 0xf3, 0x9a, 0x9e, 0x37, 0x05, 0xea, 0xe1, 0xc1, 0x03, 0xde, 0x26, 0x93, 0xa4, 0xab,
+	0x6a, 0xa8, 0xaf, 0xb9, 0x49, 0x0d, 0xcb, 0xc8, 0x65, 0xdf, 0x4a, 0xcc, 0x38, 0x76, 0xca, 0x76,
+	0x6a, 0xa6, 0x77, 0x20, 0x84, 0x80, 0x05, 0x12, 0x2b, 0xc4, 0x4f, 0x78, 0x12, 0xac, 0x58, 0x20,
+	0x84, 0x90, 0xd8, 0x3f, 0xbd, 0x3d, 0xfc, 0x02, 0x24, 0x84, 0x40, 0x2c, 0xf8, 0x01, 0xe8, 0xdc,
+	0x0f, 0xfb, 0x3a, 0x95, 0x54, 0x57, 0x37, 0xbb, 0x7b, 0x3e, 0x7c, 0xef, 0x39, 0xe7, 0xde, 0x7b,
+	0xbe, 0xae, 0xa1, 0x3d, 0x4f, 0x43, 0x1a, 0x1f, 0x2c, 0xb2, 0xb4, 0x48, 0x5d, 0x7d, 0x71, 0xf5,
+	0xbd, 0x67, 0x81, 0x79, 0x99, 0x2c, 0x73, 0x1a, 0x7a, 0x3f, 0x85, 0x9d, 0x01, 0xbd, 0xf6, 0x97,
+	0x71, 0xd1, 0x0b, 0xf2, 0xd3, 0x34, 0xa4, 0xae, 0x0b, 0x4d, 0x7f, 0x59, 0xcc, 0xba, 0xda, 0xbe,
+	0xf6, 0xdc, 0x26, 0x6c, 0xcc, 0x70, 0x49, 0x9a, 0x74, 0x1b, 0x02, 0x97, 0xa4, 0x89, 0xf7, 0x5b,
+	0x00, 0xbd, 0x20, 0xa0, 0x79, 0xf9, 0xd5, 0x77, 0x7e, 0x52, 0xc8, 0xaf, 0x70, 0xec, 0x3e, 0x06,
+	0x63, 0x1a, 0xdd, 0x52, 0xf9, 0x19, 0x07, 0xbc, 0x9f, 0x80, 0x39, 0xa2, 0xc5, 0x68, 0x79, 0xe5,
+	0xbe, 0x0f, 0xad, 0x65, 0x4e, 0xb3, 0x49, 0x14, 0x8a, 0xcf, 0x4c, 0x04, 0x8f, 0x43, 0x9c, 0x0c,
+	0x45, 0x96, 0xcb, 0xe1, 0xd8, 0xbb, 0x81, 0xd6, 0x88, 0x16, 0x03, 0x9a, 0x07, 0xee, 0x6f, 0x42,
+	0x3b, 0xe4, 0x32, 0x4f, 0xfc, 0x20, 0x67, 0xdf, 0xb6, 0x5f, 0xfe, 0xe0, 0x60, 0x71, 0xf5, 0xfd,
+	0x41, 0x5d, 0x17, 0x02, 0x61, 0x09, 0xbb, 0xef, 0x81, 0xb9, 0x58, 0x5e, 0xc5, 0x51, 0xc0, 0xa6,
+	0xed, 0x10, 0x01, 0xb9, 0x5d, 0x68, 0x2d, 0xb2, 0xe8, 0xd6, 0x2f, 0x68, 0x57, 0x67, 0x04, 0x09,
+	0x7a, 0x7f, 0xda, 0x80, 0xd6, 0x21, 0x2d, 0xce, 0x17, 0x45, 0xee, 0xbe, 0x80, 0x47, 0xd1, 0xf5,
+	0x64, 0x9e, 0x86, 0xd1, 0x75, 0x44, 0xc3, 0x49, 0x1e, 0x25, 0x01, 0x65, 0x2b, 0xeb, 0x64, 0x37,
+	0xba, 0x3e, 0x15, 0xf8, 0x11, 0xa2, 0x51, 0x7c, 0x54, 0x44, 0x8a, 0x8f, 0x63, 0xb4, 0x45, 0x91,
+	0x2e, 0xa2, 0x80, 0xad, 0x61, 0x13, 0x0e, 0xb8, 0x3f, 0x04, 0x8b, 0xcd, 0x84, 0x26, 0x68, 0xee,
+	0x6b, 0xcf, 0x0d, 0xd2, 0x62, 0xf0, 0x71, 0xe8, 0x7e, 0x00, 0xf6, 0x15, 0xbd, 0x4e, 0x33, 0x46,
+	0x33, 0x18, 0xcd, 0xe2, 0x88, 0xe3, 0x10, 0x67, 0x8b, 0xa3, 0x79, 0x54, 0x74, 0x4d, 0x46, 0xe0,
+	0x00, 0xc3, 0xfa, 0x57, 0x34, 0xee, 0xb6, 0xf8, 0x1a, 0x0c, 0x40, 0xbd, 0x8b, 0x59, 0x46, 0xfd,
+	0xb0, 0x6b, 0x31, 0x66, 0x01, 0x21, 0xf7, 0xcd, 0x92, 0x66, 0xaf, 0xbb, 0x36, 0xe7, 0x66, 0x00,
+	0xca, 0xce, 0x78, 0x61, 0x5f, 0x7b, 0x6e, 0x11, 0x36, 0xf6, 0xfe, 0x57, 0x03, 0xeb, 0x90, 0x16,
+	0x5f, 0x4b, 0x86, 0xef, 0x66, 0x7e, 0xb5, 0xd1, 0x33, 0xbf, 0x70, 0xf7, 0xa1, 0x19, 0xd2, 0x9c,
+	0x1b, 0xb6, 0xfd, 0xb2, 0xc3, 0x76, 0x42, 0x18, 0x8e, 0x30, 0x8a, 0xfb, 0x11, 0xe8, 0xf9, 0xf2,
+	0xaa, 0xab, 0xaf, 0x61, 0x40, 0x02, 0x9b, 0xc1, 0x2f, 0xfc, 0x6e, 0x73, 0x0d, 0x03, 0xa3, 0xb8,
+	0x1e, 0x18, 0x41, 0x31, 0xcf, 0xa7, 0x5d, 0x63, 0x0d, 0x0b, 0x27, 0xb9, 0x9f, 0x42, 0x2b, 0x48,
+	0x93, 0xc2, 0x0f, 0xb8, 0x61, 0x56, 0xb9, 0x24, 0xd1, 0x7d, 0x06, 0x66, 0x4e, 0xfd, 0x2c, 0x98,
+	0x75, 0x5b, 0x6b, 0xd8, 0x04, 0xcd, 0xfb, 0x57, 0x0d, 0xac, 0x91, 0x54, 0x5b, 0xaa, 0xa8, 0x29,
+	0x1f, 0x88, 0xf3, 0x28, 0x54, 0xfc, 0x90, 0xab, 0xc8, 0x6d, 0xd0, 0x96, 0x0c, 0xa3, 0xe5, 0x15,
+	0xd7, 0xd0, 0x85, 0x66, 0xe1, 0x4f, 0xf3, 0xae, 0xbe, 0xaf, 0xa3, 0xdd, 0x70, 0xec, 0x3e, 0x05,
+	0xe3, 0x2a, 0x4e, 0x83, 0x6f, 0x85, 0xda, 0xdb, 0xf2, 0xa3, 0x2f, 0x11, 0x49, 0x38, 0x0d, 0x95,
+	0x62, 0x07, 0x32, 0x78, 0x5d, 0x53, 0xfd, 0x82, 0xe3, 0x88, 0x24, 0xba, 0xbf, 0xb6, 0xaa, 0xfc,
+	0xae, 0x9c, 0xae, 0xcf, 0xd1, 0xa5, 0xfe, 0xde, 0xdf, 0x6b, 0x00, 0x15, 0x7e, 0xf3, 0x3d, 0x7c,
+	0x0f, 0xcc, 0x8c, 0xce, 0xfd, 0xec, 0x5b, 0x71, 0x94, 0x05, 0x84, 0x78, 0x76, 0xb6, 0xa4, 0x36,
+	0x02, 0x72, 0x0f, 0xa0, 0x95, 0x17, 0x7e, 0x96, 0x51, 0x7e, 0x9a, 0x77, 0x5e, 0x3e, 0x5e, 0x11,
+	0xe1, 0x60, 0x54, 0xf8, 0x19, 0x91, 0x4c, 0xde, 0x67, 0xd0, 0x44, 0x84, 0xbb, 0x0d, 0xf6, 0xe5,
+	0x59, 0xff, 0xa8, 0x77, 0x76, 0x38, 0x1c, 0x38, 0x5b, 0xae, 0x05, 0xcd, 0xd1, 0xb8, 0x47, 0x1c,
+	0xcd, 0x05, 0x30, 0x2f, 0xcf, 0xd8, 0xb8, 0xe1, 0x1d, 0x80, 0x25, 0x4d, 0xe3, 0x3a, 0xa0, 0xfb,
+	0x21, 0x4a, 0x8b, 0xab, 0xe3, 0x10, 0x31, 0x19, 0x9d, 0x77, 0x1b, 0x1c, 0x93, 0xd1, 0xb9, 0x37,
+	0x83, 0xd6, 0x45, 0x69, 0x1a, 0x47, 0xa8, 0x3e, 0xc9, 0xe8, 0xcd, 0x92, 0xe6, 0x45, 0x2e, 0x34,
+	0xdd, 0x15, 0x78, 0x22, 0xd0, 0xee, 0x1e, 0x58, 0x6c, 0x14, 0x95, 0xde, 0xae, 0x84, 0x51, 0x6d,
+	0x3f, 0xc9, 0xbf, 0xa3, 0x99, 0xb8, 0xc4, 0x02, 0xf2, 0x7e, 0x8c, 0x92, 0xdd, 0x10, 0x3f, 0x99,
+	0x52, 0x94, 0x23, 0x4e, 0xbf, 0x63, 0xb3, 0x1b, 0x04, 0x87, 0xee, 0x0e, 0x34, 0x66, 0x11, 0x9b,
+	0xcb, 0x20, 0x8d, 0x59, 0xe4, 0x25, 0x00, 0xfd, 0x8c, 0x86, 0x34, 0x29, 0x22, 0x9f, 0xdd, 0xce,
+	0x39, 0x2d, 0x66, 0x69, 0x69, 0x7a, 0x0e, 0xe1, 0xed, 0xbc, 0xf5, 0xe3, 0xa5, 0xf4, 0x81, 0x1c,
+	0x40, 0xe9, 0x32, 0x9a, 0x2f, 0xd2, 0x24, 0xa7, 0x42, 0x86, 0x12, 0x66, 0xfe, 0xcd, 0xcf, 0xfc,
+	0x79, 0xde, 0x6d, 0x0a, 0xff, 0xc6, 0x20, 0xef, 0x6f, 0x34, 0xb0, 0xfa, 0x71, 0x44, 0x93, 0xe2,
+	0x28, 0x42, 0x61, 0xca, 0x5d, 0x6e, 0x44, 0xa1, 0xfb, 0x21, 0x00, 0xdb, 0x7a, 0x7f, 0x4a, 0x93,
+	0x42, 0xac, 0x65, 0x23, 0xa6, 0x87, 0x08, 0xd4, 0xe6, 0xb6, 0x54, 0x17, 0x87, 0xe8, 0x96, 0x42,
+	0x7a, 0x1b, 0x55, 0x2e, 0xcb, 0x26, 0x16, 0x47, 0x70, 0xbf, 0x1d, 0xfb, 0x09, 0xbf, 0xa2, 0x36,
+	0x61, 0x63, 0x14, 0x79, 0x11, 0xfb, 0xc5, 0x75, 0x9a, 0xcd, 0xd9, 0xb9, 0xb4, 0x49, 0x09, 0x7b,
+	0xff, 0xa9, 0x81, 0xcd, 0x45, 0xeb, 0x05, 0xc1, 0x1d, 0xd9, 0x94, 0x63, 0xd9, 0x58, 0x3d, 0x96,
+	0x79, 0x30, 0xa3, 0x73, 0x69, 0x03, 0x01, 0x31, 0x3c, 0x0d, 0x32, 0x5a, 0x48, 0x0b, 0x70, 0x88,
+	0xf9, 0xc5, 0x74, 0x1a, 0x25, 0x4c, 0x2e, 0x8b, 0x70, 0xa0, 0xbc, 0x90, 0xa6, 0x72, 0x21, 0xe5,
+	0x2d, 0x6f, 0x6d, 0xbc, 0xe5, 0x4f, 0xa1, 0x19, 0xe0, 0xf9, 0xb6, 0xf6, 0xf5, 0xf2, 0x8a, 0x55,
+	0xdb, 0x49, 0x18, 0x91, 0x3b, 0xfb, 0x6f, 0x69, 0xc2, 0x5c, 0x6b, 0x87, 0x70, 0xc0, 0xcb, 0xa0,
+	0xcd, 0x95, 0x3d, 0x61, 0xeb, 0xaf, 0xaa, 0x5b, 0x69, 0xd5, 0xd8, 0xa0, 0x95, 0x5e, 0xd3, 0x4a,
+	0x4a, 0xd2, 0xbc, 0x47, 0x12, 0xef, 0x2f, 0x4a, 0x0b, 0x63, 0xc0, 0x5d, 0x5d, 0xb2, 0x0c, 0x4a,
+	0x0d, 0x35, 0x28, 0xbd, 0x00, 0x3b, 0xa7, 0xc5, 0x84, 0x07, 0x07, 0xbd, 0xee, 0x99, 0x98, 0x33,
+	0x24, 0x56, 0x2e, 0x46, 0xc8, 0x3b, 0x2d, 0x79, 0x55, 0x2f, 0x76, 0x58, 0xf2, 0x4e, 0xc5, 0xc8,
+	0x3b, 0x2e, 0xf5, 0xa7, 0xfe, 0x2d, 0x7d, 0xa0, 0x30, 0x8f, 0xc1, 0x58, 0x26, 0x32, 0x74, 0x58,
+	0x84, 0x03, 0xde, 0x1f, 0x35, 0xa4, 0x5a, 0x17, 0x0f, 0x56, 0xeb, 0x7d, 0x68, 0x25, 0xe9, 0x84,
+	0x06, 0xb3, 0x54, 0xcc, 0x65, 0x26, 0xe9, 0x30, 0x98, 0xa5, 0xee, 0x8f, 0xa1, 0x39, 0xa3, 0xbe,
+	0x34, 0x64, 0x97, 0x1b, 0x52, 0x4e, 0x7e, 0x70, 0x44, 0xfd, 0x70, 0x98, 0x14, 0xd9, 0x6b, 0xc2,
+	0xb8, 0x30, 0x5d, 0x40, 0x9f, 0x81, 0xd7, 0xc5, 0xe0, 0xe9, 0x82, 0x00, 0x91, 0x92, 0xd1, 0x45,
+	0xec, 0x07, 0x54, 0x84, 0x65, 0x09, 0x62, 0x98, 0xc7, 0xe1, 0xeb, 0x49, 0x91, 0x76, 0x5b, 0x15,
+	0xe9, 0xf5, 0x38, 0xdd, 0xfb, 0x6d, 0xb0, 0xcb, 0x15, 0xf0, 0xba, 0x7d, 0x4b, 0x5f, 0x0b, 0x4d,
+	0x70, 0x58, 0x77, 0x03, 0x1d, 0xe1, 0x06, 0x7e, 0xb7, 0xf1, 0x53, 0xcd, 0xfb, 0x46, 0x5a, 0xe0,
+	0x90, 0x16, 0x0f, 0xb4, 0xc0, 0x53, 0x19, 0xf1, 0xf5, 0x75, 0x1b, 0xc5, 0x69, 0xd5, 0xbc, 0xa3,
+	0xff, 0xdf, 0xbc, 0xa3, 0x95, 0x79, 0xff, 0x4c, 0x97, 0x13, 0x0f, 0x68, 0xfc, 0xc0, 0x89, 0x7f,
+	0x24, 0x72, 0x0d, 0x9d, 0x05, 0x93, 0x1f, 0x28, 0x3b, 0x33, 0xa0, 0xf1, 0xc1, 0x1f, 0xcc, 0xfc,
+	0x42, 0x24, 0x20, 0x9f, 0x42, 0x2b, 0xa4, 0xf1, 0x24, 0xa7, 0x37, 0x62, 0x17, 0xa5, 0x0c, 0xdc,
+	0x2b, 0x13, 0x33, 0xa4, 0xf1, 0x88, 0xde, 0xa8, 0x2e, 0xc5, 0x58, 0xcd, 0x38, 0x67, 0x7e, 0x16,
+	0xb2, 0x8d, 0xb3, 0x08, 0x1b, 0xbb, 0x4f, 0x60, 0x1b, 0x27, 0x0d, 0x8a, 0xc9, 0x3c, 0x9f, 0xe2,
+	0x27, 0x3c, 0xad, 0x82, 0x90, 0xc6, 0xfd, 0xe2, 0x34, 0x9f, 0x1e, 0x87, 0xee, 0x47, 0xd0, 0x16,
+	0x2c, 0x38, 0x0f, 0x4b, 0xb0, 0x6c, 0x62, 0x33, 0x86, 0x4b, 0xcc, 0xfa, 0x9e, 0xc1, 0x8e, 0xa0,
+	0xcb, 0xd0, 0xcc, 0x93, 0xad, 0x0e, 0x63, 0x91, 0xf1, 0x77, 0x0f, 0x6c, 0xc1, 0x15, 0xf1, 0xc4,
+	0xcb, 0x26, 0x2d, 0xc6, 0x70, 0x1c, 0x7a, 0x5f, 0x43, 0x13, 0xf5, 0x74, 0x5b, 0xa0, 0x9f, 0x8e,
+	0x0e, 0x9d, 0x2d, 0xd7, 0x06, 0x63, 0x7c, 0x7e, 0x71, 0xdc, 0x77, 0x34, 0xc4, 0x8d, 0x2e, 0xbf,
+	0x74, 0x1a, 0x18, 0x30, 0x2f, 0x47, 0x43, 0xe2, 0xe8, 0x48, 0xed, 0x8f, 0x91, 0xb1, 0xe9, 0xb6,
+	0xa1, 0xd5, 0x3f, 0x3f, 0x1b, 0xf7, 0xfa, 0x63, 0xc7, 0xc0, 0x40, 0x4a, 0x86, 0xfd, 0xde, 0xc9,
+	0x89, 0x63, 0x7a, 0xff, 0xa0, 0x01, 0x70, 0x2b, 0x9e, 0xa5, 0x05, 0xad, 0x4c, 0xaf, 0xa9, 0xa6,
+	0x7f, 0x22, 0x4c, 0xdf, 0x60, 0xa6, 0xe7, 0xe6, 0x3c, 0x4e, 0xae, 0x53, 0xfc, 0x44, 0x18, 0xfd,
+	0x57, 0xd0, 0x31, 0xdd, 0xa0, 0xcc, 0x3a, 0xcf, 0x42, 0x73, 0x7a, 0x73, 0xcc, 0x42, 0x8a, 0x0c,
+	0xb6, 0x65, 0x88, 0xb0, 0x05, 0xe6, 0x38, 0x74, 0x9f, 0xc2, 0xb6, 0x24, 0xe7, 0x05, 0x26, 0xdd,
+	0x3c, 0xb7, 0xed, 0x08, 0xe4, 0xa8, 0xf0, 0xb9, 0x4c, 0x74, 0x9e, 0xfe, 0x61, 0x24, 0x22, 0x06,
+	0x07, 0xbc, 0x7f, 0xd7, 0x60, 0x9b, 0x0b, 0x2e, 0x2d, 0xf7, 0xb0, 0x63, 0xc4, 0x3c, 0x68, 0x12,
+	0x56, 0x71, 0x9b, 0x43, 0x3c, 0x9a, 0x06, 0x34, 0xc2, 0x10, 0xd7, 0x94, 0xd1, 0x94, 0xc3, 0x2b,
+	0x5a, 0x18, 0xab, 0x5a, 0xc8, 0x2c, 0xd8, 0x54, 0xb2, 0xe0, 0x2e, 0xb4, 0xe6, 0x34, 0xcf, 0xfd,
+	0x29, 0x15, 0x27, 0x45, 0x82, 0x4c, 0x80, 0x74, 0x99, 0x05, 0x54, 0x9c, 0x10, 0x01, 0x29, 0x09,
+	0x85, 0x5d, 0x4b, 0x28, 0x7e, 0xae, 0x41, 0x47, 0x5c, 0xc2, 0x68, 0x9a, 0xf8, 0xf1, 0xc3, 0xf5,
+	0x2c, 0xfc, 0x6c, 0x2a, 0x22, 0x85, 0x4d, 0x04, 0xc4, 0x5d, 0xd6, 0x7c, 0xee, 0x27, 0x72, 0x3b,
+	0x24, 0xc8, 0xb2, 0xfd, 0x34, 0x9d, 0xcb, 0x80, 0x8d, 0xe3, 0xb2, 0x7a, 0x31, 0x95, 0xea, 0x05,
+	0x6b, 0x24, 0xff, 0x75, 0x9c, 0xfa, 0xfc, 0x12, 0x74, 0x88, 0x04, 0xbd, 0x7f, 0x6c, 0xca, 0x6b,
+	0x7d, 0x9a, 0x4f, 0xdd, 0x8f, 0x59, 0xae, 0xa3, 0x29, 0x6e, 0x40, 0x66, 0x1e, 0x47, 0x5b, 0x98,
+	0xfc, 0xb8, 0x1e, 0xe8, 0x7e, 0x20, 0x0b, 0x85, 0x1d, 0x85, 0xa3, 0x17, 0x04, 0x47, 0x5b, 0x04,
+	0x89, 0xee, 0x73, 0x19, 0xae, 0xb9, 0x3b, 0x71, 0x14, 0x2e, 0x16, 0x39, 0x8f, 0xb6, 0x64, 0x08,
+	0xf7, 0x78, 0xca, 0xdd, 0xbc, 0x33, 0xdb, 0x68, 0x79, 0x75, 0xb4, 0xc5, 0xf3, 0x6e, 0x9c, 0x0d,
+	0xe3, 0x4d, 0xd7, 0xb8, 0x3b, 0x1b, 0xe2, 0xd9, 0x6c, 0x38, 0xc0, 0xd9, 0x16, 0xcb, 0xab, 0xae,
+	0x79, 0x67, 0xb6, 0x0b, 0x3e, 0xdb, 0x62, 0x79, 0x85, 0x3c, 0x68, 0xdf, 0xd6, 0x1d, 0x9e, 0x43,
+	0x5a, 0x20, 0x0f, 0x9a, 0x1b, 0xa5, 0xa2, 0x45, 0xd7, 0xba, 0xc3, 0x33, 0xe2, 0x3c, 0x39, 0xe7,
+	0x09, 0x69, 0xdc, 0xb5, 0xef, 0xf0, 0x0c, 0x68, 0x8c, 0x3c, 0x21, 0x8d, 0xdd, 0x4f, 0xa0, 0x99,
+	0xa4, 0x05, 0x65, 0x1e, 0xa1, 0x0c, 0xf0, 0xe5, 0xbd, 0x3d, 0xda, 0x22, 0x8c, 0x8c, 0x49, 0xb7,
+	0x74, 0x2e, 0xdb, 0x8c, 0xd3, 0x55, 0x38, 0xc5, 0x45, 0x39, 0xda, 0xaa, 0x8a, 0x9f, 0xcf, 0xc0,
+	0xcc, 0xd9, 0xa9, 0xea, 0xee, 0x30, 0xf6, 0x47, 0xaa, 0x84, 0x8c, 0x70, 0xb4, 0x45, 0x04, 0x8b,
+	0xbb, 0x0f, 0x9d, 0x34, 0x99, 0x5c, 0xd1, 0x99, 0x1f, 0x5f, 0x4f, 0xd2, 0xeb, 0x6e, 0x9b, 0xbb,
+	0xc0, 0x34, 0xf9, 0x92, 0xa1, 0xce, 0xaf, 0xdd, 0xcf, 0x01, 0xb0, 0x45, 0x30, 0x89, 0xe9, 0x2d,
+	0x8d, 0xbb, 0x1d, 0xe6, 0x2e, 0xb8, 0x42, 0xbd, 0x65, 0x31, 0x3b, 0x41, 0x2c, 0xb1, 0x7d, 0x39,
+	0xfc, 0xd2, 0x86, 0xd6, 0x29, 0xbf, 0x15, 0xde, 0x2f, 0x1a, 0x60, 0x8f, 0xf1, 0xe0, 0x0e, 0x78,
+	0xf9, 0x04, 0x41, 0x46, 0xfd, 0x82, 0x86, 0x13, 0x51, 0x5d, 0xea, 0xc4, 0x16, 0x98, 0x5e, 0x81,
+	0xe4, 0xe5, 0x22, 0x94, 0xe4, 0x06, 0x27, 0x0b, 0x0c, 0x27, 0x17, 0xe9, 0x32, 0x98, 0x71, 0xb2,
+	0xce, 0xc9, 0x02, 0xd3, 0x63, 0x3a, 0x63, 0x27, 0x20, 0xc8, 0xc5, 0x59, 0x59, 0xdb, 0x2c, 0x10,
+	0x2c, 0xee, 0x13, 0x3c, 0xa3, 0x79, 0xd7, 0x50, 0xcc, 0x5e, 0x35, 0x3a, 0xf0, 0x88, 0xe6, 0x8a,
+	0xeb, 0x33, 0x55, 0xd7, 0xf7, 0x3e, 0x66, 0x00, 0x7e, 0x28, 0x63, 0x85, 0x81, 0x05, 0x93, 0x1f,
+	0x4a, 0x42, 0x70, 0x8b, 0x04, 0x4b, 0x12, 0x82, 0xdb, 0xe3, 0x10, 0x27, 0x42, 0xd7, 0x1f, 0x85,
+	0xec, 0x28, 0x18, 0xc4, 0x08, 0x69, 0xcc, 0x33, 0x5c, 0xd1, 0xab, 0x80, 0x4d, 0xbd, 0x8a, 0x76,
+	0xbd, 0x57, 0xf1, 0x4f, 0x3a, 0x58, 0xcc, 0x98, 0x98, 0xe7, 0xd5, 0x8d, 0xa5, 0xad, 0x31, 0x56,
+	0x48, 0x63, 0x5a, 0xb7, 0xa5, 0xc0, 0xf4, 0x0a, 0x5c, 0x3c, 0x4d, 0xe2, 0x28, 0xa1, 0x32, 0x4f,
+	0xe2, 0x90, 0xb4, 0x4b, 0xf3, 0x1e, 0xbb, 0x28, 0x06, 0x30, 0x36, 0x19, 0xc0, 0xac, 0x19, 0xa0,
+	0xd2, 0xb4, 0xb5, 0x49, 0x53, 0xab, 0xa6, 0xa9, 0x1a, 0xc3, 0xed, 0x5a, 0x0c, 0x2f, 0x9d, 0x22,
+	0xa8, 0x4e, 0xb1, 0x7e, 0x32, 0xda, 0xab, 0x27, 0xa3, 0xda, 0xc9, 0x8e, 0xba, 0x93, 0xd5, 0xbe,
+	0x6c, 0xab, 0xfb, 0xf2, 0x0c, 0x76, 0x62, 0x3f, 0x2f, 0x26, 0x39, 0xa5, 0xc9, 0xa4, 0x88, 0xe6,
+	0x94, 0xdd, 0x21, 0x9d, 0x74, 0x10, 0x3b, 0xa2, 0x34, 0x19, 0x47, 0x73, 0xea, 0xfe, 0x3a, 0x3c,
+	0xae, 0xb8, 0x94, 0xf2, 0x6a, 0x97, 0xc9, 0xf5, 0x48, 0xf2, 0x5e, 0xca, 0x32, 0xcb, 0xfb, 0x0a,
+	0xec, 0x01, 0x8d, 0xbf, 0xc1, 0xdc, 0x2e, 0x57, 0x96, 0xd6, 0xd4, 0xa5, 0x95, 0x14, 0xa7, 0x71,
+	0x4f, 0x8a, 0xe3, 0xfd, 0x82, 0xd5, 0xf6, 0xd9, 0x2d, 0xcd, 0xfa, 0x45, 0xf6, 0xd0, 0xc8, 0xe1,
+	0x42, 0x33, 0x48, 0x43, 0xbe, 0xe1, 0x06, 0x61, 0x63, 0xc4, 0x15, 0xf4, 0xfb, 0x42, 0x84, 0x0c,
+	0x36, 0x76, 0xbf, 0x28, 0x6b, 0x4c, 0x83, 0xc9, 0xf0, 0x81, 0x90, 0x41, 0x2e, 0x77, 0x70, 0xc1,
+	0xa8, 0x3c, 0x5f, 0x16, 0xac, 0x7b, 0xbf, 0x03, 0x6d, 0x05, 0xfd, 0x56, 0x49, 0xee, 0xff, 0xe8,
+	0x52, 0x99, 0x01, 0xf6, 0x80, 0xd6, 0xa7, 0x2a, 0xfb, 0xd0, 0xb9, 0xce, 0xd2, 0xf9, 0xa4, 0x5e,
+	0x2c, 0x02, 0xe2, 0x2e, 0xf9, 0xc9, 0xf8, 0x55, 0xb0, 0x71, 0xb3, 0xf2, 0xc2, 0x9f, 0x2f, 0xba,
+	0x2d, 0x71, 0x04, 0x24, 0x62, 0xe5, 0x3a, 0xe8, 0xab, 0xd7, 0xa1, 0x3a, 0x21, 0x4d, 0xf5, 0x84,
+	0x7c, 0x2e, 0xaa, 0x06, 0x6e, 0x88, 0x1f, 0x2a, 0x86, 0x40, 0x51, 0xef, 0x2b, 0x1b, 0xcc, 0x7a,
+	0xd9, 0xf0, 0x01, 0xd8, 0x34, 0x8c, 0xc4, 0xea, 0x16, 0x5b, 0xdd, 0xe2, 0x88, 0x5e, 0xe1, 0xbe,
+	0x04, 0x3b, 0xa3, 0xb7, 0x51, 0x1e, 0xa5, 0x49, 0xde, 0xb5, 0xd9, 0x52, 0xbc, 0xa7, 0x22, 0x9c,
+	0x28, 0x11, 0x44, 0x52, 0xb1, 0x89, 0xb4, 0xc6, 0x8f, 0x63, 0x2a, 0xdb, 0x78, 0x25, 0xcc, 0xe7,
+	0xf3, 0x83, 0x82, 0xcd, 0xd7, 0x5e, 0x37, 0x1f, 0x27, 0x92, 0x8a, 0xad, 0x56, 0xbd, 0x74, 0x6a,
+	0xd5, 0x8b, 0x2c, 0x79, 0x22, 0x9a, 0x8b, 0x7b, 0x22, 0xc1, 0x77, 0xaf, 0x6b, 0xbe, 0x86, 0xdd,
+	0x15, 0x59, 0xaa, 0x6c, 0x50, 0x53, 0xb2, 0x41, 0xc4, 0x06, 0xe9, 0x52, 0x74, 0x2d, 0x0c, 0xc2,
+	0x01, 0xd6, 0x3a, 0xae, 0x5c, 0x17, 0x1b, 0x7b, 0xc7, 0xca, 0x94, 0xdc, 0x48, 0xf5, 0x13, 0xa1,
+	0xad, 0x9e, 0x08, 0x65, 0xb3, 0x1a, 0xb5, 0xcd, 0xf2, 0xfe, 0xc4, 0x90, 0x07, 0xf2, 0x22, 0xa3,
+	0xf9, 0x86, 0x03, 0xe9, 0x80, 0x9e, 0x67, 0xf2, 0x86, 0xe1, 0xd0, 0x7d, 0x5e, 0x2b, 0x64, 0x1e,
+	0x2b, 0x87, 0x05, 0xa7, 0x51, 0x2b, 0x99, 0x7a, 0x43, 0xa6, 0xb9, 0xda, 0x90, 0xa9, 0x0e, 0xa3,
+	0xb1, 0xde, 0x5d, 0x99, 0x1b, 0x7c, 0x46, 0xeb, 0xbe, 0xb2, 0xe8, 0x19, 0xec, 0xf0, 0x54, 0xb1,
+	0xbc, 0x43, 0x3c, 0x4f, 0xed, 0x70, 0xac, 0xb8, 0x45, 0x1e, 0x6c, 0xfb, 0x41, 0x91, 0x66, 0x93,
+	0xba, 0xfb, 0x6d, 0x33, 0xa4, 0xe0, 0x11, 0x31, 0x02, 0xee, 0x89, 0x11, 0xf5, 0xcc, 0xba, 0xbd,
+	0x9a, 0x59, 0x7f, 0x00, 0x76, 0x3e, 0x9d, 0xf0, 0x9d, 0x67, 0xc7, 0xcd, 0x26, 0x56, 0x3e, 0xed,
+	0x31, 0xb8, 0xcc, 0x57, 0xb7, 0x95, 0x7c, 0x55, 0x89, 0x07, 0x3b, 0xab, 0x6d, 0x22, 0x11, 0x5a,
+	0x76, 0xd5, 0xd0, 0xe2, 0xfd, 0x8b, 0x26, 0x6a, 0x2a, 0x13, 0x1a, 0xe7, 0x67, 0xce, 0x16, 0xd6,
+	0x51, 0xe7, 0xaf, 0x5e, 0x39, 0x1a, 0x22, 0x2e, 0x7b, 0x8e, 0x8e, 0x88, 0xcb, 0x8b, 0x81, 0xd3,
+	0xc4, 0xc2, 0xea, 0xf0, 0xfc, 0x6c, 0xe8, 0x18, 0x88, 0xea, 0xf5, 0x47, 0x8e, 0x89, 0xa8, 0xf1,
+	0x90, 0x9c, 0x3a, 0x2d, 0x59, 0x92, 0x59, 0x88, 0x22, 0xc3, 0xde, 0xc0, 0xb1, 0xf9, 0xa8, 0xff,
+	0x8d, 0x03, 0x48, 0x1c, 0x0c, 0x4f, 0x9c, 0x36, 0xaf, 0xc8, 0x7a, 0x83, 0x81, 0xd3, 0x71, 0x3b,
+	0x60, 0xf5, 0xc7, 0x64, 0xf8, 0xd5, 0xb0, 0x3f, 0x76, 0xb6, 0x59, 0x7d, 0x36, 0xee, 0x1d, 0x92,
+	0xe1, 0xd0, 0xd9, 0xc1, 0xfa, 0xac, 0x3f, 0x3e, 0xc5, 0x2f, 0x76, 0x71, 0x3c, 0x3a, 0x3e, 0x3c,
+	0xeb, 0x9d, 0x38, 0x0e, 0xff, 0x1a, 0xd1, 0x8f, 0xf8, 0x10, 0xc5, 0x72, 0xbd, 0xff, 0xc6, 0x0a,
+	0x8e, 0x5b, 0x0c, 0xb3, 0xee, 0x35, 0x4d, 0x3d, 0x25, 0x95, 0x6a, 0xac, 0xa6, 0x52, 0xef, 0x52,
+	0x0e, 0x3d, 0x06, 0x43, 0xad, 0xd6, 0x38, 0xa0, 0x58, 0xd8, 0x5c, 0x0d, 0xde, 0x6f, 0x59, 0x09,
+	0x7d, 0x08, 0x40, 0xbf, 0x5f, 0x44, 0x19, 0xcd, 0x51, 0x64, 0x9b, 0x8b, 0x2c, 0x30, 0xbd, 0xc2,
+	0xfb, 0xab, 0x06, 0xb4, 0x36, 0xd5, 0x7c, 0x6f, 0xd0, 0x56, 0x39, 0x1e, 0x7a, 0xed, 0x78, 0xbc,
+	0xa1, 0x4e, 0xad, 0x74, 0x33, 0x6a, 0xba, 0xd5, 0x73, 0x2b, 0xf3, 0xfe, 0xdc, 0xaa, 0xb5, 0x26,
+	0xb7, 0x12, 0x1d, 0x75, 0x6b, 0x43, 0x47, 0xdd, 0xae, 0x75, 0xd4, 0xbb, 0x55, 0x47, 0x9d, 0xbb,
+	0x72, 0x09, 0x7a, 0x7f, 0x5b, 0x86, 0xc6, 0x53, 0x5a, 0xf8, 0x0f, 0x8c, 0xf3, 0x9e, 0xe8, 0x6f,
+	0xea, 0x4a, 0xdd, 0x51, 0xa6, 0xe0, 0xa2, 0xc3, 0xf9, 0xb1, 0x2c, 0xaa, 0x2a, 0x87, 0x21, 0x13,
+	0x4b, 0xf9, 0x56, 0xc3, 0x6a, 0x17, 0x43, 0x99, 0xa3, 0xcc, 0x5e, 0x78, 0xe5, 0xb2, 0xae, 0xb5,
+	0xfa, 0x89, 0x7c, 0xbf, 0x69, 0xa9, 0xfd, 0xca, 0xf2, 0x10, 0xaf, 0x79, 0xc2, 0xe1, 0x2d, 0xd6,
+	0x8e, 0xca, 0x58, 0x55, 0x31, 0xfb, 0xa0, 0x47, 0x01, 0x15, 0x21, 0x91, 0x0b, 0x71, 0x1c, 0x50,
+	0x6e, 0x10, 0x82, 0x24, 0xec, 0x60, 0x60, 0xd0, 0x13, 0xbe, 0x48, 0x54, 0xa3, 0x7e, 0x1c, 0x63,
+	0x17, 0x83, 0x30, 0x12, 0x5a, 0x97, 0xbd, 0xb1, 0xd0, 0x90, 0xc5, 0x42, 0x9b, 0x48, 0x50, 0x7d,
+	0x74, 0xe9, 0xdc, 0xf7, 0xe8, 0xf2, 0x69, 0xf9, 0x92, 0xb4, 0xad, 0x48, 0x32, 0x62, 0xa8, 0xa3,
+	0xa8, 0x28, 0xdf, 0x92, 0xfe, 0x59, 0x03, 0xbb, 0xc4, 0x6e, 0x08, 0x1b, 0x95, 0x6f, 0x6f, 0xa8,
+	0xbe, 0x7d, 0x35, 0xbd, 0xd1, 0xef, 0x4f, 0x6f, 0x9a, 0xab, 0xc1, 0x4c, 0xe6, 0x71, 0x86, 0x92,
+	0xc7, 0x1d, 0x00, 0xcc, 0xa2, 0xe9, 0x2c, 0x8e, 0xa6, 0xb3, 0x82, 0x6f, 0x93, 0x14, 0xfd, 0x48,
+	0xa2, 0x89, 0xc2, 0xe1, 0x7d, 0x0e, 0x76, 0x49, 0xc0, 0xa3, 0x26, 0xaa, 0x0a, 0x83, 0x34, 0x7c,
+	0xf6, 0x48, 0x10, 0x8b, 0x47, 0x5e, 0x7c, 0xf2, 0xa0, 0x89, 0xb7, 0x04, 0xbb, 0xdc, 0x0c, 0xd6,
+	0x4f, 0xc8, 0xe2, 0x5c, 0x3c, 0xd6, 0xb0, 0x31, 0xba, 0x1a, 0x54, 0x27, 0xf1, 0xcb, 0x6e, 0x77,
+	0x09, 0xbb, 0x1f, 0xb1, 0xfb, 0x2c, 0xda, 0xd8, 0x52, 0xdb, 0x0a, 0x83, 0x9b, 0x26, 0x1c, 0x83,
+	0xd0, 0x55, 0x82, 0xde, 0x9f, 0xe3, 0x4b, 0x87, 0xd8, 0xe1, 0x32, 0x54, 0x68, 0x4a, 0xa8, 0x78,
+	0x0c, 0xc6, 0x9c, 0x86, 0x91, 0x2f, 0x2f, 0x05, 0x03, 0x2a, 0xdf, 0xc6, 0xd7, 0xe2, 0x80, 0xeb,
+	0x41, 0x67, 0xe1, 0x67, 0x45, 0x14, 0x44, 0x0b, 0x3f, 0x29, 0x72, 0x76, 0x1f, 0x6c, 0x52, 0xc3,
+	0xc9, 0xdb, 0x59, 0x50, 0x1e, 0x8e, 0x75, 0x22, 0x41, 0xef, 0xdf, 0xca, 0x2c, 0x9c, 0x09, 0xf3,
+	0xae, 0x89, 0xeb, 0x93, 0x5a, 0xde, 0xf0, 0x86, 0x2e, 0x5c, 0x73, 0x73, 0x17, 0xce, 0x78, 0x63,
+	0x17, 0xce, 0xbc, 0xaf, 0x0b, 0xd7, 0x52, 0xbb, 0x70, 0x3f, 0xd7, 0x60, 0x5b, 0x64, 0xfc, 0xe2,
+	0x2e, 0xae, 0x7b, 0x12, 0xae, 0x82, 0x4c, 0x63, 0x63, 0x90, 0xd1, 0xef, 0xed, 0xb9, 0xdd, 0xf1,
+	0xc8, 0x4a, 0x54, 0x31, 0x36, 0x45, 0x15, 0x53, 0x8d, 0x2a, 0xde, 0x5f, 0x6b, 0xd0, 0xe1, 0xa2,
+	0x8a, 0x3e, 0x5a, 0xd5, 0x21, 0xd3, 0x36, 0x75, 0xc8, 0x1a, 0xeb, 0x3b, 0x64, 0x7a, 0xbd, 0x43,
+	0x86, 0x7b, 0x24, 0x2b, 0xa3, 0xeb, 0x8c, 0xe3, 0x58, 0xd7, 0xcc, 0x58, 0xdf, 0x35, 0x33, 0xeb,
+	0x5d, 0xb3, 0x5f, 0x36, 0xc0, 0xe6, 0x82, 0x61, 0xfc, 0xfe, 0x04, 0x9a, 0x41, 0x91, 0xc5, 0xa2,
+	0x6f, 0xb6, 0xbb, 0x52, 0x53, 0x61, 0xa3, 0x07, 0xc9, 0xc8, 0xc6, 0xde, 0xc8, 0x1b, 0x77, 0xd8,
+	0xb0, 0xe2, 0x40, 0x36, 0x24, 0x23, 0xdb, 0x02, 0x2f, 0x87, 0x7e, 0x87, 0x0d, 0x73, 0x4d, 0x64,
+	0x43, 0x32, 0xb2, 0xcd, 0x69, 0xf9, 0xe2, 0xae, 0xb2, 0x61, 0x3c, 0x41, 0x36, 0x24, 0x23, 0x5b,
+	0x94, 0x5c, 0xa7, 0x5d, 0xe3, 0x0e, 0x1b, 0x9e, 0x43, 0x64, 0x43, 0xb2, 0xda, 0x84, 0x6a, 0x29,
+	0x4d, 0xa8, 0xda, 0x39, 0x59, 0xdf, 0x84, 0xb2, 0x94, 0x26, 0x94, 0xba, 0x57, 0x4a, 0x13, 0xaa,
+	0xbc, 0x3d, 0xa6, 0x72, 0x7b, 0xd4, 0x4e, 0xd2, 0x1f, 0x97, 0xb7, 0x8d, 0xd0, 0x7c, 0xe1, 0x7e,
+	0x02, 0x26, 0x1e, 0xea, 0x25, 0x7f, 0xe4, 0x95, 0xf7, 0x06, 0x49, 0x7d, 0xd6, 0xe7, 0xe1, 0x44,
+	0xe6, 0xbb, 0xb3, 0x5b, 0x0c, 0x49, 0x6a, 0x3b, 0xb2, 0xdc, 0x16, 0x22, 0xa8, 0xee, 0x33, 0x30,
+	0x82, 0x18, 0xd9, 0xf4, 0x3b, 0xdd, 0x3a, 0x1e, 0xb8, 0x90, 0xe8, 0xfd, 0x87, 0x86, 0x3f, 0xa8,
+	0xe4, 0xac, 0xba, 0xf8, 0x10, 0x20, 0xe7, 0xc3, 0xea, 0x4d, 0xdd, 0x16, 0x98, 0xe3, 0x7b, 0x1e,
+	0x36, 0xeb, 0xbd, 0x34, 0xfd, 0x0d, 0xbd, 0x34, 0xf7, 0x63, 0x68, 0x67, 0x74, 0x9e, 0x16, 0x74,
+	0xe2, 0x87, 0xa1, 0xcc, 0xd9, 0x80, 0xa3, 0x7a, 0x61, 0x98, 0xad, 0x14, 0x13, 0xc6, 0x6a, 0x31,
+	0x51, 0x7b, 0xcb, 0x35, 0x57, 0xde, 0x72, 0xf7, 0xc0, 0xc2, 0xf7, 0xdb, 0x65, 0x95, 0xc4, 0x95,
+	0xb0, 0x77, 0x2e, 0x7b, 0xbe, 0x84, 0xde, 0x60, 0x24, 0x46, 0xe3, 0x68, 0x6b, 0x8d, 0x83, 0x24,
+	0x7c, 0x55, 0x45, 0xe5, 0x6b, 0xbf, 0x87, 0x08, 0x53, 0x11, 0x46, 0xf1, 0x7e, 0x06, 0x6d, 0x1e,
+	0x1d, 0xf9, 0xab, 0xe2, 0xc6, 0x1f, 0x12, 0xca, 0x7f, 0x56, 0x1a, 0xca, 0x3f, 0x2b, 0xde, 0x8d,
+	0xfc, 0xfa, 0x55, 0xba, 0x4c, 0xc2, 0x87, 0x6e, 0xff, 0xda, 0xb9, 0xf0, 0xe3, 0x8c, 0xe6, 0xcb,
+	0xb8, 0x60, 0xbf, 0x36, 0xdc, 0x49, 0x80, 0x04, 0xd1, 0x9b, 0x02, 0x30, 0xdc, 0xf0, 0x16, 0x0d,
+	0xf9, 0x04, 0x4c, 0x51, 0xb0, 0xf0, 0x15, 0x6d, 0xf1, 0x18, 0xbb, 0x0c, 0x89, 0x20, 0xa0, 0x7f,
+	0x50, 0xa2, 0x1d, 0x1b, 0x3f, 0x24, 0x1b, 0xf3, 0xfe, 0x4e, 0x83, 0x4e, 0x2f, 0x60, 0xb5, 0xed,
+	0x83, 0xd7, 0xda, 0x78, 0xbe, 0x56, 0x7e, 0x9c, 0xd2, 0xdf, 0xf6, 0xc7, 0xa9, 0x66, 0x2d, 0x13,
+	0x96, 0x59, 0x9e, 0x55, 0x65, 0x79, 0xde, 0x7f, 0x69, 0xf0, 0x68, 0xb4, 0xbc, 0xca, 0x83, 0x2c,
+	0x5a, 0xa0, 0x2c, 0x0f, 0x96, 0x79, 0xe3, 0x9b, 0xed, 0xfa, 0xe4, 0xbd, 0x2a, 0x78, 0x9b, 0x6a,
+	0xc1, 0xfb, 0xf6, 0xfd, 0xc7, 0xa7, 0xe2, 0x57, 0xb3, 0xd6, 0xfa, 0x8a, 0x95, 0x11, 0x37, 0x37,
+	0x23, 0xbd, 0x31, 0x74, 0x84, 0x13, 0x7a, 0xb0, 0xa6, 0x4f, 0xf8, 0x7d, 0x59, 0xef, 0xc5, 0xd9,
+	0x85, 0xf1, 0xfe, 0x12, 0xdf, 0x7f, 0xb8, 0xa7, 0x7c, 0x9b, 0x03, 0x56, 0x3e, 0xd8, 0xc9, 0x20,
+	0xfc, 0xae, 0xb5, 0x0f, 0x2f, 0x26, 0x0c, 0x59, 0x4c, 0xbc, 0xf8, 0x02, 0xec, 0xd2, 0x01, 0x61,
+	0x8d, 0x7b, 0x86, 0x35, 0x31, 0xfb, 0x4f, 0xa7, 0x77, 0x76, 0x7e, 0xe6, 0x00, 0x1b, 0x5d, 0x8e,
+	0x8f, 0x9c, 0xc7, 0x38, 0x22, 0xe7, 0xe7, 0x63, 0xe7, 0xa3, 0x17, 0x5f, 0x81, 0x25, 0x53, 0x95,
+	0xb2, 0x42, 0xde, 0x2a, 0x2b, 0x64, 0x56, 0x6c, 0xff, 0xfe, 0x85, 0xd3, 0xe0, 0xa5, 0x2f, 0xa3,
+	0xb2, 0xe7, 0x4b, 0x32, 0xc4, 0x17, 0x4b, 0xf6, 0x7c, 0x79, 0x79, 0xc6, 0x01, 0xe3, 0xc5, 0xcf,
+	0xc0, 0x92, 0xf7, 0x97, 0x55, 0xd1, 0xe7, 0x67, 0xe3, 0xe3, 0xb3, 0x4b, 0x21, 0xc3, 0x80, 0x9c,
+	0x5f, 0x38, 0x1a, 0x7e, 0x40, 0x86, 0xa3, 0x8b, 0xf3, 0xb3, 0x81, 0xd3, 0xe0, 0xc0, 0xc5, 0x49,
+	0xaf, 0x3f, 0x74, 0xf4, 0x17, 0x2f, 0xa0, 0x89, 0xa6, 0x62, 0x2b, 0x91, 0x61, 0x6f, 0x8c, 0xdf,
+	0xe1, 0x9f, 0x45, 0x17, 0x03, 0x1c, 0xb3, 0xbf, 0x8c, 0x06, 0xc3, 0x93, 0xe1, 0x78, 0xe8, 0x34,
+	0x5e, 0xfe, 0x1e, 0x34, 0xcf, 0x70, 0x95, 0x2f, 0xa0, 0x2d, 0x36, 0xf6, 0x24, 0x4d, 0x17, 0xee,
+	0x8a, 0x5f, 0xdb, 0x5b, 0x89, 0x15, 0xde, 0xd6, 0x73, 0xed, 0x37, 0xb4, 0x97, 0xbf, 0x6c, 0x80,
+	0x79, 0x11, 0x2f, 0xf1, 0x69, 0xea, 0x73, 0xb0, 0x5e, 0x45, 0x19, 0x3d, 0x4a, 0x73, 0x5a, 0xfb,
+	0x98, 0xd0, 0x9b, 0x3d, 0x75, 0xd3, 0x51, 0x2d, 0x6f, 0x0b, 0xff, 0x41, 0x78, 0x15, 0x25, 0xa1,
+	0xeb, 0x28, 0xf5, 0x03, 0xf3, 0x85, 0x7b, 0x2a, 0x86, 0xf9, 0x37, 0x6f, 0xcb, 0xfd, 0x0c, 0x5a,
+	0xc2, 0x27, 0xb8, 0x8f, 0xe4, 0x89, 0x2d, 0x3d, 0xc4, 0x1e, 0xff, 0xf7, 0x4c, 0xfc, 0xdf, 0xb9,
+	0xe5, 0xfe, 0x08, 0x0c, 0xe6, 0x54, 0xdc, 0xdd, 0xca, 0xc1, 0xac, 0x65, 0xfc, 0x09, 0x74, 0xd4,
+	0xab, 0xeb, 0xbe, 0xc7, 0x57, 0x5e, 0xbd, 0xcd, 0xab, 0x9f, 0x7d, 0x56, 0xc6, 0x61, 0x21, 0x8c,
+	0x7a, 0x21, 0xd6, 0x30, 0xcb, 0xac, 0xf1, 0x91, 0x5a, 0xd8, 0xad, 0x63, 0xbe, 0x32, 0xd9, 0x1f,
+	0xab, 0x5f, 0xfc, 0xdf, 0x00, 0xbf, 0xfe, 0xcf, 0xe1, 0xc0, 0x2a, 0x00, 0x00,
 }
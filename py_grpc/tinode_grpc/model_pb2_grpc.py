@@ -86,6 +86,11 @@ class PluginStub(object):
         request_serializer=model__pb2.MessageEvent.SerializeToString,
         response_deserializer=model__pb2.Unused.FromString,
         )
+    self.Contact = channel.unary_unary(
+        '/pbx.Plugin/Contact',
+        request_serializer=model__pb2.ContactEvent.SerializeToString,
+        response_deserializer=model__pb2.Unused.FromString,
+        )
 
 
 class PluginServicer(object):
@@ -141,6 +146,13 @@ class PluginServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def Contact(self, request, context):
+    """Contact request created, rejected or accepted, contact deleted
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
 
 def add_PluginServicer_to_server(servicer, server):
   rpc_method_handlers = {
@@ -174,6 +186,11 @@ def add_PluginServicer_to_server(servicer, server):
           request_deserializer=model__pb2.MessageEvent.FromString,
           response_serializer=model__pb2.Unused.SerializeToString,
       ),
+      'Contact': grpc.unary_unary_rpc_method_handler(
+          servicer.Contact,
+          request_deserializer=model__pb2.ContactEvent.FromString,
+          response_serializer=model__pb2.Unused.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'pbx.Plugin', rpc_method_handlers)
@@ -0,0 +1,331 @@
+/******************************************************************************
+ *
+ *  Description:
+ *
+ *  Block list and privacy settings of the user. Contact requests, P2P messages,
+ *  calls and presence notifications from blocked users are silently dropped.
+ *
+ *****************************************************************************/
+
+package main
+
+import (
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/tinode/chat/server/store"
+	"github.com/tinode/chat/server/store/types"
+)
+
+// Maximum number of users which can be blocked or unblocked in one request.
+const maxBlockListChange = 128
+
+// Maximum length of the verification question and answer in bytes.
+const maxPrivacyQuestionLength = 256
+
+// loadBlockList loads the block list of the user into the 'me' topic.
+func (t *Topic) loadBlockList(uid types.Uid) error {
+	blocked, err := store.BlockList.GetAll(uid)
+	if err != nil {
+		return err
+	}
+
+	t.blocked = make(map[types.Uid]bool, len(blocked))
+	for _, target := range blocked {
+		t.blocked[target] = true
+	}
+	return nil
+}
+
+// isBlocked checks if the 'me' topic owner has blocked the user with the given user ID, like "usrXXX".
+func (t *Topic) isBlocked(userId string) bool {
+	if len(t.blocked) == 0 {
+		return false
+	}
+	return t.blocked[types.ParseUserId(userId)]
+}
+
+// loadPeerBlocks loads into the P2P topic which of the two users has blocked the other one.
+func (t *Topic) loadPeerBlocks() error {
+	for uid, pud := range t.perUser {
+		blocked, err := store.BlockList.IsBlocked(uid, t.p2pOtherUser(uid))
+		if err != nil {
+			return err
+		}
+		pud.blocked = blocked
+		t.perUser[uid] = pud
+	}
+	t.publishPeerBlocks()
+	return nil
+}
+
+// publishPeerBlocks makes the current block flags of the P2P topic available to other goroutines.
+func (t *Topic) publishPeerBlocks() {
+	blocks := make(map[types.Uid]bool, len(t.perUser))
+	for uid, pud := range t.perUser {
+		blocks[uid] = pud.blocked
+	}
+	t.peerBlocks.Store(blocks)
+}
+
+// isBlockedByPeer checks if the other party of the P2P topic has blocked the user.
+func (t *Topic) isBlockedByPeer(uid types.Uid) bool {
+	return t.perUser[t.p2pOtherUser(uid)].blocked
+}
+
+// isPeerBlocked checks if the user has blocked the peer. The state kept by the P2P topic of the two
+// users is used if the topic is loaded. Safe to call from any goroutine.
+func isPeerBlocked(user, peer types.Uid) bool {
+	if t := globals.hub.topicGet(user.P2PName(peer)); t != nil {
+		if blocks, ok := t.peerBlocks.Load().(map[types.Uid]bool); ok {
+			return blocks[user]
+		}
+	}
+	return isBlockedBy(user, peer)
+}
+
+// notifyPeerBlock tells the P2P topic of the two users, if it's loaded, that the user has blocked
+// or unblocked the target. The topic may be loaded on another cluster node.
+func notifyPeerBlock(user, target types.Uid, blocked bool) {
+	what := "unblock"
+	if blocked {
+		what = "block"
+	}
+	topic := user.P2PName(target)
+	msg := &ServerComMessage{
+		Pres:   &MsgServerPres{Topic: topic, What: what, Src: user.UserId()},
+		rcptto: topic}
+	if globals.cluster.isRemoteTopic(topic) {
+		if err := globals.cluster.routePres(msg.Pres, topic); err != nil {
+			log.Println("failed to route block notification", topic, err)
+		}
+		return
+	}
+	globals.hub.route <- msg
+}
+
+// isBlockedBy checks if the user has blocked the target. Errors are treated as not blocked.
+func isBlockedBy(user, target types.Uid) bool {
+	blocked, err := store.BlockList.IsBlocked(user, target)
+	if err != nil {
+		log.Println("failed to check block list", user.UserId(), err)
+	}
+	return blocked
+}
+
+// replyGetBlocked reports the block list of the user.
+func (t *Topic) replyGetBlocked(sess *Session, asUid types.Uid, id string) error {
+	now := types.TimeNow()
+
+	if t.cat != types.TopicCatMe {
+		sess.queueOut(ErrOperationNotAllowed(id, t.original(asUid), now))
+		return errors.New("block list is available on 'me' topic only")
+	}
+
+	if len(t.blocked) > 0 {
+		blocked := make([]string, 0, len(t.blocked))
+		for uid := range t.blocked {
+			blocked = append(blocked, uid.UserId())
+		}
+		sess.queueOut(&ServerComMessage{
+			Meta: &MsgServerMeta{Id: id, Topic: t.original(asUid), Timestamp: &now, Blocked: blocked}})
+		return nil
+	}
+
+	// Inform the requester that the block list is empty.
+	reply := NoErr(id, t.original(asUid), now)
+	reply.Ctrl.Params = map[string]string{"what": "blocked"}
+	sess.queueOut(reply)
+
+	return nil
+}
+
+// replySetBlock adds users to and removes them from the block list.
+func (t *Topic) replySetBlock(sess *Session, asUid types.Uid, set *MsgClientSet) error {
+	now := types.TimeNow()
+
+	if t.cat != types.TopicCatMe {
+		sess.queueOut(ErrOperationNotAllowed(set.Id, t.original(asUid), now))
+		return errors.New("block list is available on 'me' topic only")
+	}
+
+	block := set.Block
+	if len(block.Add)+len(block.Remove) > maxBlockListChange {
+		sess.queueOut(ErrTooLarge(set.Id, t.original(asUid), now))
+		return errors.New("too many users in block list update")
+	}
+
+	parse := func(list []string) ([]types.Uid, error) {
+		uids := make([]types.Uid, 0, len(list))
+		for _, userId := range list {
+			uid := types.ParseUserId(userId)
+			if uid.IsZero() || uid == asUid {
+				return nil, types.ErrMalformed
+			}
+			uids = append(uids, uid)
+		}
+		return uids, nil
+	}
+
+	add, err := parse(block.Add)
+	if err != nil {
+		sess.queueOut(ErrMalformed(set.Id, t.original(asUid), now))
+		return err
+	}
+	remove, err := parse(block.Remove)
+	if err != nil {
+		sess.queueOut(ErrMalformed(set.Id, t.original(asUid), now))
+		return err
+	}
+
+	var added, removed int
+	for _, uid := range add {
+		if t.blocked[uid] {
+			continue
+		}
+		if err := store.BlockList.Add(asUid, uid); err != nil {
+			sess.queueOut(decodeStoreError(err, set.Id, t.original(asUid), now, nil))
+			return err
+		}
+		t.blocked[uid] = true
+		notifyPeerBlock(asUid, uid, true)
+		added++
+	}
+	for _, uid := range remove {
+		if !t.blocked[uid] {
+			continue
+		}
+		if err := store.BlockList.Delete(asUid, uid); err != nil {
+			sess.queueOut(decodeStoreError(err, set.Id, t.original(asUid), now, nil))
+			return err
+		}
+		delete(t.blocked, uid)
+		notifyPeerBlock(asUid, uid, false)
+		removed++
+	}
+
+	if added == 0 && removed == 0 {
+		sess.queueOut(InfoNotModified(set.Id, t.original(asUid), now))
+		return nil
+	}
+
+	resp := NoErr(set.Id, t.original(asUid), now)
+	params := make(map[string]interface{})
+	if added > 0 {
+		params["added"] = added
+	}
+	if removed > 0 {
+		params["removed"] = removed
+	}
+	resp.Ctrl.Params = params
+	sess.queueOut(resp)
+
+	return nil
+}
+
+// replyGetPrivacy reports privacy settings of the user.
+func (t *Topic) replyGetPrivacy(sess *Session, asUid types.Uid, id string) error {
+	now := types.TimeNow()
+
+	if t.cat != types.TopicCatMe {
+		sess.queueOut(ErrOperationNotAllowed(id, t.original(asUid), now))
+		return errors.New("privacy settings are available on 'me' topic only")
+	}
+
+	user, err := store.Users.Get(asUid)
+	if user == nil && err == nil {
+		err = types.ErrNotFound
+	}
+	if err != nil {
+		sess.queueOut(decodeStoreError(err, id, t.original(asUid), now, nil))
+		return err
+	}
+
+	privacy := &MsgPrivacy{
+		ContactRequests: user.Privacy.ContactRequests,
+		Question:        user.Privacy.Question,
+		Answer:          user.Privacy.Answer,
+	}
+	if privacy.ContactRequests == "" {
+		privacy.ContactRequests = types.ContactRequestsAll
+	}
+	sess.queueOut(&ServerComMessage{
+		Meta: &MsgServerMeta{Id: id, Topic: t.original(asUid), Timestamp: &now, Privacy: privacy}})
+
+	return nil
+}
+
+// replySetPrivacy updates privacy settings of the user.
+func (t *Topic) replySetPrivacy(sess *Session, asUid types.Uid, set *MsgClientSet) error {
+	now := types.TimeNow()
+
+	if t.cat != types.TopicCatMe {
+		sess.queueOut(ErrOperationNotAllowed(set.Id, t.original(asUid), now))
+		return errors.New("privacy settings are available on 'me' topic only")
+	}
+
+	privacy := types.Privacy{
+		ContactRequests: set.Privacy.ContactRequests,
+		Question:        strings.TrimSpace(set.Privacy.Question),
+		Answer:          strings.TrimSpace(set.Privacy.Answer),
+	}
+	switch privacy.ContactRequests {
+	case "", types.ContactRequestsAll, types.ContactRequestsFoF, types.ContactRequestsNone:
+		// Question and answer are meaningful for "verify" only.
+		privacy.Question, privacy.Answer = "", ""
+	case types.ContactRequestsVerify:
+		if privacy.Question == "" || privacy.Answer == "" {
+			sess.queueOut(ErrMalformed(set.Id, t.original(asUid), now))
+			return errors.New("verification requires both question and answer")
+		}
+	default:
+		sess.queueOut(ErrMalformed(set.Id, t.original(asUid), now))
+		return errors.New("invalid contact request privacy")
+	}
+	if len(privacy.Question) > maxPrivacyQuestionLength || len(privacy.Answer) > maxPrivacyQuestionLength {
+		sess.queueOut(ErrTooLarge(set.Id, t.original(asUid), now))
+		return errors.New("verification question or answer is too long")
+	}
+
+	if err := store.Users.Update(asUid, map[string]interface{}{"Privacy": privacy}); err != nil {
+		sess.queueOut(decodeStoreError(err, set.Id, t.original(asUid), now, nil))
+		return err
+	}
+
+	sess.queueOut(NoErr(set.Id, t.original(asUid), now))
+	return nil
+}
+
+// checkContactRequest checks if the user's privacy settings permit a contact request from the sender.
+// Returns nil if the request is permitted, otherwise the error to send to the sender.
+func checkContactRequest(msg *ServerComMessage, topic string, from, to types.Uid) *ServerComMessage {
+	user, err := store.Users.Get(to)
+	if user == nil && err == nil {
+		err = types.ErrNotFound
+	}
+	if err != nil {
+		return decodeStoreError(err, msg.id, topic, msg.timestamp, nil)
+	}
+
+	switch user.Privacy.ContactRequests {
+	case types.ContactRequestsNone:
+		return ErrPermissionDenied(msg.id, topic, msg.timestamp)
+	case types.ContactRequestsFoF:
+		common, err := store.Contact.HaveCommon(from, to)
+		if err != nil {
+			return decodeStoreError(err, msg.id, topic, msg.timestamp, nil)
+		}
+		if !common {
+			return ErrPermissionDenied(msg.id, topic, msg.timestamp)
+		}
+	case types.ContactRequestsVerify:
+		if !strings.EqualFold(strings.TrimSpace(msg.Contact.answer), user.Privacy.Answer) {
+			// Tell the sender what to answer.
+			resp := ErrPermissionDenied(msg.id, topic, msg.timestamp)
+			resp.Ctrl.Params = map[string]string{"what": "verify", "question": user.Privacy.Question}
+			return resp
+		}
+	}
+	return nil
+}
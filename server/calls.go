@@ -0,0 +1,675 @@
+/******************************************************************************
+ *
+ *  Description:
+ *
+ *  Server-side state of audio and video calls negotiated with {signal}.
+ *
+ *****************************************************************************/
+
+package main
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tinode/chat/server/push"
+	"github.com/tinode/chat/server/store"
+	"github.com/tinode/chat/server/store/types"
+)
+
+// Call states reported to clients in {pres what="signal"} as sgAction.
+const (
+	// The callee is being notified of the call. Incoming call is reported to the callee as "audio" or "video".
+	callStateRinging = "ringing"
+	// The call was answered by one of the callee's devices.
+	callStateAccepted = "accepted"
+	// The callee declined the call.
+	callStateDeclined = "declined"
+	// The callee is already engaged in another call.
+	callStateBusy = "busy"
+	// The call was not answered in time or was cancelled by the caller before it was answered.
+	callStateMissed = "missed"
+	// The call was hung up after it was answered.
+	callStateEnded = "ended"
+)
+
+// Group call events reported to members of the group topic in {pres what="signal"} as sgAction.
+const (
+	// A member joined the group call.
+	callEventJoin = "join"
+	// A participant left the group call which is still going on.
+	callEventLeave = "leave"
+)
+
+const (
+	// Default time to wait for the callee to answer.
+	defaultCallRingTimeout = 30 * time.Second
+	// Default maximum number of participants in a group call.
+	defaultCallMaxParticipants = 8
+)
+
+// callConfig is the configuration of audio/video calls.
+type callConfig struct {
+	// Seconds to wait for the callee to answer before the call is reported as missed.
+	RingTimeout int `json:"ring_timeout"`
+	// Maximum number of users who can join a group call.
+	MaxParticipants int `json:"max_participants"`
+}
+
+// callSession is a single audio or video call.
+type callSession struct {
+	room string
+	// Name of the topic the call was placed in. The call history is saved there.
+	topic string
+	// "audio" or "video"
+	media   string
+	caller  types.Uid
+	callees []types.Uid
+	state   string
+	// Group call: members of the group topic join and leave the room independently.
+	group bool
+	// Users currently in the room of a group call, in order of joining.
+	participants []types.Uid
+	// Session of the callee which answered the call.
+	answeredSid string
+	// Time when the call started ringing and when it was answered.
+	startedAt  time.Time
+	answeredAt time.Time
+	// Fires when the call is not answered in time.
+	timer *time.Timer
+}
+
+// isParty checks if the user is the caller or one of the callees.
+func (c *callSession) isParty(uid types.Uid) bool {
+	return uid == c.caller || c.isCallee(uid)
+}
+
+func (c *callSession) isCallee(uid types.Uid) bool {
+	for _, callee := range c.callees {
+		if callee == uid {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *callSession) isParticipant(uid types.Uid) bool {
+	for _, p := range c.participants {
+		if p == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// isOver checks if the call has ended and is no longer registered.
+func (c *callSession) isOver() bool {
+	return c.state != callStateRinging && c.state != callStateAccepted
+}
+
+// info returns description of the call for {meta}. Must be called with the registry lock held.
+func (c *callSession) info() *MsgCallInfo {
+	participants := make([]string, len(c.participants))
+	for i, uid := range c.participants {
+		participants[i] = uid.UserId()
+	}
+	started := c.startedAt
+	return &MsgCallInfo{
+		Room:         c.room,
+		Media:        c.media,
+		State:        c.state,
+		Participants: participants,
+		Started:      &started,
+	}
+}
+
+// others returns all parties of the call except the given user.
+func (c *callSession) others(uid types.Uid) []types.Uid {
+	var out []types.Uid
+	if c.caller != uid {
+		out = append(out, c.caller)
+	}
+	for _, callee := range c.callees {
+		if callee != uid {
+			out = append(out, callee)
+		}
+	}
+	return out
+}
+
+// CallRegistry keeps track of active calls. Calls are indexed by room and by participating users.
+// The state is kept in memory of the current node only, and the parties of a call may be served by
+// different cluster nodes. Because of that calls cannot be placed when the server runs in a cluster.
+type CallRegistry struct {
+	lock sync.Mutex
+
+	ringTimeout     time.Duration
+	maxParticipants int
+
+	// Active calls indexed by room ID.
+	rooms map[string]*callSession
+	// Room ID of the active call indexed by user ID.
+	users map[types.Uid]string
+	// Room ID of the active group call indexed by group topic name.
+	topics map[string]string
+}
+
+func newCallRegistry(conf *callConfig) *CallRegistry {
+	cr := &CallRegistry{
+		ringTimeout:     defaultCallRingTimeout,
+		maxParticipants: defaultCallMaxParticipants,
+		rooms:           make(map[string]*callSession),
+		users:           make(map[types.Uid]string),
+		topics:          make(map[string]string),
+	}
+	if conf != nil {
+		if conf.RingTimeout > 0 {
+			cr.ringTimeout = time.Duration(conf.RingTimeout) * time.Second
+		}
+		if conf.MaxParticipants > 0 {
+			cr.maxParticipants = conf.MaxParticipants
+		}
+	}
+	return cr
+}
+
+// start registers a new ringing call. The returned call is in callStateBusy and is not registered if any
+// of the callees is already engaged in another call. A group call is started with the caller as the only
+// participant; the callees are not engaged until they join. If the group topic already has an active call,
+// the existing call is returned with types.ErrDuplicate.
+func (cr *CallRegistry) start(room, topic, media string, caller types.Uid, callees []types.Uid,
+	group bool) (*callSession, error) {
+
+	cr.lock.Lock()
+	defer cr.lock.Unlock()
+
+	if _, ok := cr.rooms[room]; ok {
+		return nil, types.ErrDuplicate
+	}
+	if group {
+		if existing, ok := cr.topics[topic]; ok {
+			return cr.rooms[existing], types.ErrDuplicate
+		}
+	}
+	if _, ok := cr.users[caller]; ok {
+		return nil, types.ErrPolicy
+	}
+
+	call := &callSession{
+		room:      room,
+		topic:     topic,
+		media:     media,
+		caller:    caller,
+		callees:   callees,
+		state:     callStateRinging,
+		group:     group,
+		startedAt: types.TimeNow(),
+	}
+
+	if group {
+		call.participants = []types.Uid{caller}
+		cr.topics[topic] = room
+	} else {
+		for _, uid := range callees {
+			if _, ok := cr.users[uid]; ok {
+				call.state = callStateBusy
+				return call, nil
+			}
+		}
+		for _, uid := range callees {
+			cr.users[uid] = room
+		}
+	}
+
+	cr.rooms[room] = call
+	cr.users[caller] = room
+
+	call.timer = time.AfterFunc(cr.ringTimeout, func() {
+		cr.timeout(room)
+	})
+
+	return call, nil
+}
+
+// answer changes state of a ringing call to either callStateAccepted or callStateDeclined.
+// Group calls are not answered but joined.
+func (cr *CallRegistry) answer(room string, uid types.Uid, sid string, accept bool) (*callSession, error) {
+	cr.lock.Lock()
+	defer cr.lock.Unlock()
+
+	call := cr.rooms[room]
+	if call == nil {
+		return nil, types.ErrNotFound
+	}
+	if !call.isCallee(uid) {
+		return nil, types.ErrPermissionDenied
+	}
+	if call.state != callStateRinging || call.group {
+		return nil, types.ErrPolicy
+	}
+
+	call.timer.Stop()
+	if accept {
+		call.state = callStateAccepted
+		call.answeredSid = sid
+		call.answeredAt = types.TimeNow()
+	} else {
+		call.state = callStateDeclined
+		cr.remove(call)
+	}
+
+	return call, nil
+}
+
+// sharesCall checks if both users are parties of the active call in the room.
+func (cr *CallRegistry) sharesCall(room string, one, two types.Uid) bool {
+	cr.lock.Lock()
+	defer cr.lock.Unlock()
+
+	call := cr.rooms[room]
+	if call == nil {
+		return false
+	}
+	return (call.isParty(one) || call.isParticipant(one)) && (call.isParty(two) || call.isParticipant(two))
+}
+
+// join adds a member of the group topic to the room of the group call.
+func (cr *CallRegistry) join(room string, uid types.Uid) (*callSession, error) {
+	cr.lock.Lock()
+	defer cr.lock.Unlock()
+
+	call := cr.rooms[room]
+	if call == nil {
+		return nil, types.ErrNotFound
+	}
+	if !call.group || !call.isParty(uid) {
+		return nil, types.ErrPermissionDenied
+	}
+	if call.isParticipant(uid) {
+		return nil, types.ErrDuplicate
+	}
+	if _, ok := cr.users[uid]; ok {
+		// The user is engaged in another call.
+		return nil, types.ErrPolicy
+	}
+	if len(call.participants) >= cr.maxParticipants {
+		return nil, types.ErrPolicy
+	}
+
+	call.participants = append(call.participants, uid)
+	cr.users[uid] = room
+	if call.state == callStateRinging {
+		call.timer.Stop()
+		call.state = callStateAccepted
+		call.answeredAt = types.TimeNow()
+	}
+
+	return call, nil
+}
+
+// hangup terminates the call. A ringing call becomes callStateMissed, an accepted call becomes callStateEnded.
+// A participant leaving a group call terminates it only when the room becomes empty.
+func (cr *CallRegistry) hangup(room string, uid types.Uid) (*callSession, error) {
+	cr.lock.Lock()
+	defer cr.lock.Unlock()
+
+	call := cr.rooms[room]
+	if call == nil {
+		return nil, types.ErrNotFound
+	}
+	if call.group {
+		if !call.isParticipant(uid) {
+			return nil, types.ErrPermissionDenied
+		}
+		for i, p := range call.participants {
+			if p == uid {
+				call.participants = append(call.participants[:i], call.participants[i+1:]...)
+				break
+			}
+		}
+		delete(cr.users, uid)
+		if len(call.participants) > 0 {
+			return call, nil
+		}
+	} else if !call.isParty(uid) {
+		return nil, types.ErrPermissionDenied
+	}
+
+	call.timer.Stop()
+	if call.state == callStateRinging {
+		call.state = callStateMissed
+	} else {
+		call.state = callStateEnded
+	}
+	cr.remove(call)
+
+	return call, nil
+}
+
+// timeout is called when the callee failed to answer in time.
+func (cr *CallRegistry) timeout(room string) {
+	cr.lock.Lock()
+	call := cr.rooms[room]
+	if call == nil || call.state != callStateRinging {
+		cr.lock.Unlock()
+		return
+	}
+	call.state = callStateMissed
+	cr.remove(call)
+	cr.lock.Unlock()
+
+	for _, uid := range call.others(types.ZeroUid) {
+		presCallState(uid, call.caller, call.state, call.room, "")
+	}
+	if rcpt := makeSignalReceipt(call.caller, call.callees, pushEventCallMissed, call.room, call.state); rcpt != nil {
+		push.Push(rcpt.rcpt)
+	}
+	call.saveHistory()
+}
+
+// userOffline terminates the call the user is engaged in, if any. Called when the last
+// session of the user goes away.
+func (cr *CallRegistry) userOffline(uid types.Uid) {
+	cr.lock.Lock()
+	room, ok := cr.users[uid]
+	cr.lock.Unlock()
+
+	if !ok {
+		return
+	}
+
+	if call, err := cr.hangup(room, uid); err == nil {
+		call.presHangup(uid, "")
+	}
+}
+
+// topicGone tears down the group call in the topic which is being deleted.
+func (cr *CallRegistry) topicGone(topic string) {
+	cr.lock.Lock()
+	room, ok := cr.topics[topic]
+	if !ok {
+		cr.lock.Unlock()
+		return
+	}
+	call := cr.rooms[room]
+	call.timer.Stop()
+	call.state = callStateEnded
+	cr.remove(call)
+	cr.lock.Unlock()
+
+	for _, uid := range call.others(types.ZeroUid) {
+		presCallState(uid, call.caller, call.state, call.room, "")
+	}
+}
+
+// groupCall returns description of the active group call in the topic or nil.
+func (cr *CallRegistry) groupCall(topic string) *MsgCallInfo {
+	cr.lock.Lock()
+	defer cr.lock.Unlock()
+
+	if room, ok := cr.topics[topic]; ok {
+		return cr.rooms[room].info()
+	}
+	return nil
+}
+
+// remove deletes the call from the registry. Must be called with the lock held.
+func (cr *CallRegistry) remove(call *callSession) {
+	delete(cr.rooms, call.room)
+	if call.group && cr.topics[call.topic] == call.room {
+		delete(cr.topics, call.topic)
+	}
+	for _, uid := range call.others(types.ZeroUid) {
+		if cr.users[uid] == call.room {
+			delete(cr.users, uid)
+		}
+	}
+}
+
+// saveHistory saves the record of a finished call as a message in the topic where the call was placed.
+// The message is marked with the "webrtc" header set to the final state of the call.
+func (c *callSession) saveHistory() {
+	if c.topic == "" {
+		return
+	}
+
+	now := types.TimeNow()
+	callees := make([]string, len(c.callees))
+	for i, uid := range c.callees {
+		callees[i] = uid.UserId()
+	}
+	content := map[string]interface{}{
+		"room":    c.room,
+		"media":   c.media,
+		"caller":  c.caller.UserId(),
+		"callees": callees,
+		"state":   c.state,
+		"start":   c.startedAt,
+		"end":     now,
+	}
+	if !c.answeredAt.IsZero() {
+		// Duration of the conversation in seconds.
+		content["duration"] = int(now.Sub(c.answeredAt) / time.Second)
+	}
+	head := map[string]interface{}{"webrtc": c.state}
+
+	// The topic assigns the SeqId, notifies subscribers and sends pushes. If the topic is not loaded,
+	// the hub saves the message.
+	globals.hub.route <- &ServerComMessage{
+		Data: &MsgServerData{
+			Topic:     c.topic,
+			From:      c.caller.UserId(),
+			Timestamp: now,
+			Head:      head,
+			Content:   content},
+		rcptto:    c.topic,
+		timestamp: now,
+		from:      c.caller.UserId()}
+}
+
+// presHangup tells the other parties that the user has left the call: either the group call goes on
+// without the user or the call is over.
+func (c *callSession) presHangup(uid types.Uid, skipSid string) {
+	state := c.state
+	if !c.isOver() {
+		state = callEventLeave
+	}
+	for _, other := range c.others(uid) {
+		presCallState(other, uid, state, c.room, "")
+	}
+	presCallState(uid, uid, state, c.room, skipSid)
+	if c.isOver() {
+		c.saveHistory()
+	}
+}
+
+// presCallState notifies all sessions of a user except skipSid that the state of the call has changed.
+func presCallState(uid, src types.Uid, state, room, skipSid string) {
+	globals.hub.route <- &ServerComMessage{
+		Pres: &MsgServerPres{
+			Topic:    "me",
+			What:     "signal",
+			Src:      src.UserId(),
+			User:     uid.UserId(),
+			SgAction: state,
+			Room:     room,
+		},
+		rcptto:  uid.UserId(),
+		skipSid: skipSid,
+	}
+}
+
+// replySignal handles {signal} sent by the user to the 'me' topic. Called by the social handler, topic is
+// the name of the topic as sent by the user. Returns push receipt to send (if any) and false if the message
+// should not be forwarded to the user's other sessions.
+func replySignal(msg *ServerComMessage, topic string, asUid types.Uid) (*pushReceipt, bool) {
+	sig := msg.Signal
+	switch sig.Command {
+	case "audio", "video":
+		if globals.cluster != nil {
+			// Call state is not shared between cluster nodes.
+			msg.sess.queueOut(ErrNotImplemented(msg.id, topic, msg.timestamp))
+			return nil, false
+		}
+		if strings.HasPrefix(sig.Target, "usr") {
+			// P2P call addressed to the user: convert to the name of the p2p topic.
+			sig.Target = asUid.P2PName(types.ParseUserId(sig.Target))
+		}
+		subs, err := store.Topics.GetSubs(sig.Target, nil)
+		if err != nil {
+			msg.sess.queueOut(ErrUnknown(msg.id, topic, msg.timestamp))
+			return nil, false
+		}
+		group := types.GetTopicCat(sig.Target) == types.TopicCatGrp
+		var callees []types.Uid
+		var isMember bool
+		var blockedBy int
+		for i := range subs {
+			if uid := types.ParseUid(subs[i].User); uid == asUid {
+				isMember = true
+			} else if !group && isPeerBlocked(uid, asUid) {
+				// The peer who blocked the caller is not called.
+				blockedBy++
+			} else {
+				callees = append(callees, uid)
+			}
+		}
+		if len(callees) == 0 && blockedBy == 0 {
+			msg.sess.queueOut(ErrNotFound(msg.id, topic, msg.timestamp))
+			return nil, false
+		}
+		if !isMember {
+			msg.sess.queueOut(ErrPermissionDenied(msg.id, topic, msg.timestamp))
+			return nil, false
+		}
+		if sig.Room == "" {
+			sig.Room = store.GetUidString()
+		}
+		if len(callees) == 0 {
+			// The peer has blocked the caller: pretend the call is ringing.
+			msg.sess.queueOut(NoErrParams(msg.id, topic,
+				map[string]interface{}{"room": sig.Room, "state": callStateRinging}, msg.timestamp))
+			return nil, false
+		}
+
+		call, err := globals.callRegistry.start(sig.Room, sig.Target, sig.Command, asUid, callees, group)
+		if err == types.ErrDuplicate {
+			reply := ErrAlreadyExists(msg.id, topic, msg.timestamp)
+			if call != nil {
+				// The group topic already has an active call. Tell the caller which room to join.
+				reply.Ctrl.Params = map[string]interface{}{"room": call.room}
+			}
+			msg.sess.queueOut(reply)
+			return nil, false
+		} else if err != nil {
+			// The caller is already engaged in another call.
+			msg.sess.queueOut(decodeStoreError(err, msg.id, topic, msg.timestamp, nil))
+			return nil, false
+		}
+
+		msg.sess.queueOut(NoErrParams(msg.id, topic,
+			map[string]interface{}{"room": call.room, "state": call.state}, msg.timestamp))
+		if call.state == callStateBusy {
+			call.saveHistory()
+			return nil, false
+		}
+
+		presSignal(sig.Command, sig.Room, asUid, subs)
+		return makeSignalReceipt(asUid, callees, pushEventCallIncoming, sig.Room, sig.Command), true
+
+	case "join":
+		call, err := globals.callRegistry.join(sig.Room, asUid)
+		if err != nil {
+			msg.sess.queueOut(decodeStoreError(err, msg.id, topic, msg.timestamp, nil))
+			return nil, false
+		}
+		msg.sess.queueOut(NoErrParams(msg.id, topic,
+			map[string]interface{}{"room": call.room, "state": call.state}, msg.timestamp))
+
+		// Tell all members of the group and user's other sessions that the user is in the room now.
+		for _, uid := range call.others(asUid) {
+			presCallState(uid, asUid, callEventJoin, call.room, "")
+		}
+		presCallState(asUid, asUid, callEventJoin, call.room, msg.sess.sid)
+		return nil, false
+
+	case "accept", "decline":
+		call, err := globals.callRegistry.answer(sig.Room, asUid, msg.sess.sid, sig.Command == "accept")
+		if err != nil {
+			msg.sess.queueOut(decodeStoreError(err, msg.id, topic, msg.timestamp, nil))
+			return nil, false
+		}
+		msg.sess.queueOut(NoErrParams(msg.id, topic,
+			map[string]interface{}{"room": call.room, "state": call.state}, msg.timestamp))
+
+		// Tell the caller and the other callees, then stop ringing on callee's other devices.
+		for _, uid := range call.others(asUid) {
+			presCallState(uid, asUid, call.state, call.room, "")
+		}
+		presCallState(asUid, asUid, call.state, call.room, msg.sess.sid)
+		if call.state == callStateDeclined {
+			call.saveHistory()
+		}
+		return nil, false
+
+	case "leave":
+		call, err := globals.callRegistry.hangup(sig.Room, asUid)
+		if err != nil {
+			msg.sess.queueOut(decodeStoreError(err, msg.id, topic, msg.timestamp, nil))
+			return nil, false
+		}
+		msg.sess.queueOut(NoErrParams(msg.id, topic,
+			map[string]interface{}{"room": call.room, "state": call.state}, msg.timestamp))
+
+		call.presHangup(asUid, msg.sess.sid)
+		if call.state == callStateMissed && asUid == call.caller {
+			return makeSignalReceipt(asUid, call.callees, pushEventCallCancelled, call.room, sig.Command), false
+		}
+		return nil, false
+	}
+
+	// Free-form command: relay to the target as is. The user must be subscribed to the target.
+	subs, err := store.Topics.GetSubs(sig.Target, nil)
+	if err != nil {
+		msg.sess.queueOut(ErrUnknown(msg.id, topic, msg.timestamp))
+		return nil, false
+	}
+	isMember := false
+	for i := range subs {
+		if types.ParseUid(subs[i].User) == asUid {
+			isMember = true
+			break
+		}
+	}
+	if !isMember {
+		msg.sess.queueOut(ErrPermissionDenied(msg.id, topic, msg.timestamp))
+		return nil, false
+	}
+	presSignal(sig.Command, sig.Room, asUid, subs)
+	return nil, true
+}
+
+// replyGetCall is a response to a get.call request on a group topic: the active group call and its participants.
+func (t *Topic) replyGetCall(sess *Session, asUid types.Uid, id string) error {
+	now := types.TimeNow()
+
+	if t.cat != types.TopicCatGrp {
+		sess.queueOut(ErrPermissionDenied(id, t.original(asUid), now))
+		return types.ErrPermissionDenied
+	}
+
+	if call := globals.callRegistry.groupCall(t.name); call != nil {
+		sess.queueOut(&ServerComMessage{
+			Meta: &MsgServerMeta{
+				Id:        id,
+				Topic:     t.original(asUid),
+				Timestamp: &now,
+				Call:      call,
+			}})
+	} else {
+		sess.queueOut(NoErr(id, t.original(asUid), now))
+	}
+
+	return nil
+}
@@ -114,6 +114,20 @@ type ClusterReq struct {
 	SessGone bool
 }
 
+// ClusterRoute is a server-generated message forwarded to the node which owns the topic.
+type ClusterRoute struct {
+	// Name of the node sending this request
+	Node string
+
+	// Ring hash signature of the node sending this request
+	Signature string
+
+	// Expanded (routable) topic name
+	RcptTo string
+	// Presence notification for the topic
+	Pres *MsgServerPres
+}
+
 // ClusterResp is a Master to Proxy response message.
 type ClusterResp struct {
 	Msg []byte
@@ -330,6 +344,22 @@ func (c *Cluster) Master(msg *ClusterReq, rejected *bool) error {
 	return nil
 }
 
+// Route at topic's master node receives server-generated messages for the topic from other nodes.
+// The message is passed to the hub like it was generated locally.
+// Called by a remote node.
+func (c *Cluster) Route(msg *ClusterRoute, rejected *bool) error {
+	log.Printf("cluster: Route request received from node '%s'", msg.Node)
+
+	if msg.Signature != c.ring.Signature() {
+		// Reject the request: wrong signature, cluster is out of sync.
+		*rejected = true
+		return nil
+	}
+
+	globals.hub.route <- &ServerComMessage{Pres: msg.Pres, rcptto: msg.RcptTo}
+	return nil
+}
+
 // Proxy receives messages from the master node addressed to a specific local session.
 // Called by Session.writeRPC
 func (Cluster) Proxy(msg *ClusterResp, unused *bool) error {
@@ -418,6 +448,26 @@ func (c *Cluster) routeToTopic(msg *ClientComMessage, topic string, sess *Sessio
 				Sid:        sess.sid}})
 }
 
+// Forward server-generated presence notification to the Master (cluster node which owns the topic)
+func (c *Cluster) routePres(pres *MsgServerPres, topic string) error {
+	n := c.nodeForTopic(topic)
+	if n == nil {
+		return errors.New("attempt to route to non-existent node")
+	}
+
+	rejected := false
+	err := n.call("Cluster.Route",
+		&ClusterRoute{
+			Node:      c.thisNodeName,
+			Signature: c.ring.Signature(),
+			RcptTo:    topic,
+			Pres:      pres}, &rejected)
+	if err == nil && rejected {
+		err = errors.New("cluster: master node out of sync")
+	}
+	return err
+}
+
 // Session terminated at origin. Inform remote Master nodes that the session is gone.
 func (c *Cluster) sessionGone(sess *Session) error {
 	if c == nil {
@@ -0,0 +1,346 @@
+/******************************************************************************
+ *
+ *  Description:
+ *
+ *  Contact (friend) requests: greeting, source, expiration and limits.
+ *
+ *****************************************************************************/
+
+package main
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/tinode/chat/server/auth"
+	"github.com/tinode/chat/server/store"
+	"github.com/tinode/chat/server/store/types"
+)
+
+const (
+	// Default number of days after which a pending contact request expires.
+	defaultContactRequestTTLDays = 30
+	// Default maximum number of pending outgoing contact requests per user.
+	defaultMaxPendingContactRequests = 50
+	// Default maximum length of the greeting in runes.
+	defaultMaxContactGreetingLength = 200
+)
+
+const (
+	// Maximum length of a contact remark in runes.
+	maxContactRemarkLength = 64
+	// Maximum number of labels assigned to one contact.
+	maxContactLabels = 16
+	// Maximum length of one label in runes.
+	maxContactLabelLength = 32
+)
+
+// Sources of contact requests. A group topic name is accepted too.
+const (
+	contactSourceSearch = "search"
+	contactSourceQRCode = "qrcode"
+	contactSourceCard   = "card"
+)
+
+// contactsConfig is the configuration of contact requests.
+type contactsConfig struct {
+	// Number of days after which a pending request expires. Negative value: never expire.
+	RequestTTLDays int `json:"request_ttl_days"`
+	// Maximum number of pending outgoing requests per user. Negative value: unlimited.
+	MaxPendingRequests int `json:"max_pending_requests"`
+	// Maximum length of the greeting in runes.
+	MaxGreetingLength int `json:"max_greeting_length"`
+}
+
+// contactPolicy holds the limits of contact requests.
+type contactPolicy struct {
+	// Zero means requests never expire.
+	requestTTL time.Duration
+	// Zero means unlimited.
+	maxPending        int
+	maxGreetingLength int
+}
+
+func newContactPolicy(conf *contactsConfig) *contactPolicy {
+	cp := &contactPolicy{
+		requestTTL:        defaultContactRequestTTLDays * 24 * time.Hour,
+		maxPending:        defaultMaxPendingContactRequests,
+		maxGreetingLength: defaultMaxContactGreetingLength,
+	}
+	if conf != nil {
+		if conf.RequestTTLDays > 0 {
+			cp.requestTTL = time.Duration(conf.RequestTTLDays) * 24 * time.Hour
+		} else if conf.RequestTTLDays < 0 {
+			cp.requestTTL = 0
+		}
+		if conf.MaxPendingRequests > 0 {
+			cp.maxPending = conf.MaxPendingRequests
+		} else if conf.MaxPendingRequests < 0 {
+			cp.maxPending = 0
+		}
+		if conf.MaxGreetingLength > 0 {
+			cp.maxGreetingLength = conf.MaxGreetingLength
+		}
+	}
+	return cp
+}
+
+// expires returns expiration time of a request created now or nil if requests don't expire.
+func (cp *contactPolicy) expires(now time.Time) *time.Time {
+	if cp.requestTTL == 0 {
+		return nil
+	}
+	when := now.Add(cp.requestTTL)
+	return &when
+}
+
+// validContactSource checks if the source of a contact request is either empty, a known value or
+// a group topic which the user is subscribed to.
+func validContactSource(src string, user types.Uid) bool {
+	switch src {
+	case "", contactSourceSearch, contactSourceQRCode, contactSourceCard:
+		return true
+	}
+	if len(src) <= 3 || !strings.HasPrefix(src, "grp") {
+		return false
+	}
+	sub, err := store.Subs.Get(src, user)
+	return err == nil && sub != nil
+}
+
+// replyContactAdd handles a contact request from user to contact. If the users are contacts already
+// the request fails, otherwise the request replaces any previous one and the contact is notified.
+// Requests to a contact who blocked the user are accepted but not delivered. On success returns
+// the receipt of the push notification to the contact and true. Otherwise the error is sent to
+// the session and false is returned.
+func replyContactAdd(msg *ServerComMessage, topic string, user, contact types.Uid) (*pushReceipt, bool) {
+	now := types.TimeNow()
+	policy := globals.contacts
+
+	greeting := strings.TrimSpace(msg.Contact.Message)
+	if utf8.RuneCountInString(greeting) > policy.maxGreetingLength {
+		msg.sess.queueOut(ErrTooLarge(msg.id, topic, now))
+		return nil, false
+	}
+	if !validContactSource(msg.Contact.Source, user) {
+		msg.sess.queueOut(ErrMalformed(msg.id, topic, now))
+		return nil, false
+	}
+
+	isAddedContact, err := store.Contact.IsAdded(user, contact)
+	if err != nil {
+		msg.sess.queueOut(decodeStoreError(err, msg.id, topic, now, nil))
+		return nil, false
+	}
+	if isAddedContact {
+		msg.sess.queueOut(ErrAlreadyExists(msg.id, topic, now))
+		return nil, false
+	}
+
+	// Requests to users who blocked the sender are silently dropped.
+	if isBlockedBy(contact, user) {
+		return nil, true
+	}
+	if resp := checkContactRequest(msg, topic, user, contact); resp != nil {
+		msg.sess.queueOut(resp)
+		return nil, false
+	}
+
+	if policy.maxPending > 0 {
+		count, err := store.ContMsg.CountPending(user)
+		if err != nil {
+			msg.sess.queueOut(decodeStoreError(err, msg.id, topic, now, nil))
+			return nil, false
+		}
+		if count >= policy.maxPending {
+			msg.sess.queueOut(ErrPolicy(msg.id, topic, now))
+			return nil, false
+		}
+	}
+
+	// Previous request between the two users is replaced with the new one. Fails if the contact
+	// has sent a request to the user which is still pending.
+	contactId, err := store.ContMsg.Save(user, contact, greeting, msg.Contact.Source, policy.expires(now))
+	if err != nil {
+		msg.sess.queueOut(decodeStoreError(err, msg.id, topic, now, nil))
+		return nil, false
+	}
+
+	presContactMessage("ctadd", user, contact, contactId)
+	pluginContact("add", user, contact, contactId)
+	return makeContactReceipt(user, contact, pushEventContactRequest), true
+}
+
+// replyContactRespond accepts or rejects the request from contact to user. If accepted, the users
+// become contacts. Both copies of the request are changed atomically. On success returns the receipt
+// of the push notification to the contact and true. Otherwise the error is sent to the session and
+// false is returned.
+func replyContactRespond(msg *ServerComMessage, topic string, user, contact types.Uid, agree bool) (*pushReceipt, bool) {
+	if err := store.ContMsg.Respond(user, contact, agree); err != nil {
+		if err == types.ErrExpired {
+			msg.sess.queueOut(ErrGone(msg.id, topic, msg.timestamp))
+		} else {
+			msg.sess.queueOut(decodeStoreError(err, msg.id, topic, msg.timestamp, nil))
+		}
+		return nil, false
+	}
+
+	if !agree {
+		presContactMessage("ctreject", user, contact, msg.Contact.ContactId)
+		pluginContact("reject", user, contact, msg.Contact.ContactId)
+		return makeContactReceipt(user, contact, pushEventContactRejected), true
+	}
+
+	// Restore the P2P access which may have been revoked when the friendship was removed.
+	if err := setP2PWriteAccess(globals.hub, user, contact, true); err != nil {
+		log.Println("failed to restore p2p access", user.UserId(), contact.UserId(), err)
+	}
+	presContactMessage("ctagree", user, contact, msg.Contact.ContactId)
+	pluginContact("agree", user, contact, msg.Contact.ContactId)
+	return makeContactReceipt(user, contact, pushEventContactAccepted), true
+}
+
+// setP2PWriteAccess revokes the permission of both users to write into their P2P topic or restores
+// it. Only the permission revoked here is restored, not the one a user has taken away from the other.
+// A live topic is told about the change through the regular subscription update.
+func setP2PWriteAccess(h *Hub, one, two types.Uid, allow bool) error {
+	topic := one.P2PName(two)
+
+	for _, pair := range [][2]types.Uid{{one, two}, {two, one}} {
+		uid, other := pair[0], pair[1]
+		sub, err := store.Subs.Get(topic, uid)
+		if err != nil {
+			return err
+		}
+		if sub == nil {
+			continue
+		}
+
+		var given types.AccessMode
+		if allow {
+			revoked, err := store.Contact.IsRevoked(uid, other)
+			if err != nil {
+				return err
+			}
+			if !revoked {
+				continue
+			}
+			if err = store.Contact.SetRevoked(uid, other, false); err != nil {
+				return err
+			}
+			given = sub.ModeGiven | types.ModeWrite
+		} else {
+			given = sub.ModeGiven &^ types.ModeWrite
+			if given == sub.ModeGiven {
+				continue
+			}
+			if err = store.Contact.SetRevoked(uid, other, true); err != nil {
+				return err
+			}
+		}
+		if given == sub.ModeGiven {
+			continue
+		}
+
+		if err = store.Subs.Update(topic, uid,
+			map[string]interface{}{"ModeGiven": given}, true); err != nil {
+			return err
+		}
+
+		// The other user changes the access of the user. Ignored by the hub if the topic is offline.
+		h.meta <- &metaReq{
+			topic: topic,
+			pkt: &ClientComMessage{
+				Set: &MsgClientSet{Topic: topic,
+					MsgSetQuery: MsgSetQuery{Sub: &MsgSetSub{User: uid.UserId(), Mode: given.String()}}},
+				from:    other.UserId(),
+				authLvl: int(auth.LevelAuth)},
+			what: constMsgMetaSub}
+	}
+
+	return nil
+}
+
+// replySetContact updates user's metadata of a contact: remark, labels and the starred flag.
+// The user's other sessions are notified of the change.
+func (t *Topic) replySetContact(sess *Session, asUid types.Uid, set *MsgClientSet) error {
+	now := types.TimeNow()
+
+	if t.cat != types.TopicCatMe {
+		sess.queueOut(ErrOperationNotAllowed(set.Id, t.original(asUid), now))
+		return errors.New("contacts are available on 'me' topic only")
+	}
+
+	req := set.Contact
+	contact := types.ParseUserId(req.User)
+	if contact.IsZero() || contact == asUid {
+		sess.queueOut(ErrMalformed(set.Id, t.original(asUid), now))
+		return errors.New("invalid contact")
+	}
+
+	update := make(map[string]interface{})
+	if req.Remark != "" {
+		remark := strings.TrimSpace(req.Remark)
+		if remark == nullValue {
+			remark = ""
+		} else if utf8.RuneCountInString(remark) > maxContactRemarkLength {
+			sess.queueOut(ErrTooLarge(set.Id, t.original(asUid), now))
+			return errors.New("contact remark is too long")
+		}
+		update["Remark"] = remark
+	}
+	if len(req.Labels) > 0 {
+		labels, err := normalizeContactLabels(req.Labels)
+		if err != nil {
+			sess.queueOut(ErrTooLarge(set.Id, t.original(asUid), now))
+			return err
+		}
+		update["Labels"] = labels
+	}
+	if req.Starred != nil {
+		update["Starred"] = *req.Starred
+	}
+
+	if len(update) == 0 {
+		sess.queueOut(InfoNotModified(set.Id, t.original(asUid), now))
+		return nil
+	}
+
+	if err := store.Contact.Update(asUid, contact, update); err != nil {
+		sess.queueOut(decodeStoreError(err, set.Id, t.original(asUid), now, nil))
+		return err
+	}
+
+	presSingleUserOfflineOffline(asUid, contact.UserId(), "ctupd", nilPresParams, sess.sid)
+	sess.queueOut(NoErr(set.Id, t.original(asUid), now))
+	return nil
+}
+
+// normalizeContactLabels trims labels and removes empty and duplicate ones. A single Del
+// string clears the labels.
+func normalizeContactLabels(src []string) (types.StringSlice, error) {
+	if len(src) == 1 && src[0] == nullValue {
+		return types.StringSlice{}, nil
+	}
+
+	labels := make(types.StringSlice, 0, len(src))
+	seen := make(map[string]bool, len(src))
+	for _, label := range src {
+		label = strings.TrimSpace(label)
+		if label == "" || seen[label] {
+			continue
+		}
+		if utf8.RuneCountInString(label) > maxContactLabelLength {
+			return nil, errors.New("contact label is too long")
+		}
+		seen[label] = true
+		labels = append(labels, label)
+	}
+	if len(labels) > maxContactLabels {
+		return nil, errors.New("too many contact labels")
+	}
+	return labels, nil
+}
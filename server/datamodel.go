@@ -9,6 +9,7 @@ package main
  *****************************************************************************/
 
 import (
+	"encoding/json"
 	"net/http"
 	"strings"
 	"time"
@@ -28,6 +29,14 @@ type MsgGetOpts struct {
 	BeforeId int `json:"before,omitempty"`
 	// Limit the number of messages loaded
 	Limit int `json:"limit,omitempty"`
+	// Return contacts with this label only
+	Label string `json:"label,omitempty"`
+	// Load replies to the message with this ID only
+	Thread int `json:"thread,omitempty"`
+	// Full-text search query
+	Query string `json:"query,omitempty"`
+	// Search only messages which the user has already read
+	Read bool `json:"read,omitempty"`
 }
 
 // MsgGetQuery is a topic metadata or data query.
@@ -38,7 +47,7 @@ type MsgGetQuery struct {
 	Desc *MsgGetOpts `json:"desc,omitempty"`
 	// Parameters of "sub" request: User, Topic, IfModifiedSince, Limit.
 	Sub *MsgGetOpts `json:"sub,omitempty"`
-	// Parameters of "data" request: Since, Before, Limit.
+	// Parameters of "data" request: Since, Before, Limit, Thread.
 	Data *MsgGetOpts `json:"data,omitempty"`
 	// Parameters of "del" request: Since, Before, Limit.
 	Del *MsgGetOpts `json:"del,omitempty"`
@@ -46,6 +55,8 @@ type MsgGetQuery struct {
 	ContactMsg *MsgGetOpts `json:"ctmsg,omitempty"`
 	// Parameters of "contact" request: Since, Before, Limit.
 	Contact *MsgGetOpts `json:"contact,omitempty"`
+	// Parameters of "search" request: Query, Before, Limit, Read.
+	Search *MsgGetOpts `json:"search,omitempty"`
 }
 
 // MsgSetSub is a payload in set.sub request to update current subscription or invite another user, {sub.what} == "sub"
@@ -72,6 +83,43 @@ type MsgSetQuery struct {
 	Sub *MsgSetSub `json:"sub,omitempty"`
 	// Indexable tags for user discovery
 	Tags []string `json:"tags,omitempty"`
+	// Changes to the block list, 'me' topic only
+	Block *MsgSetBlock `json:"block,omitempty"`
+	// Privacy settings, 'me' topic only
+	Privacy *MsgPrivacy `json:"privacy,omitempty"`
+	// Metadata of a contact, 'me' topic only
+	Contact *MsgSetContact `json:"contact,omitempty"`
+}
+
+// MsgSetContact is a request to update user's metadata of a contact. Fields which are not set
+// are not changed. Use a string with a single Unicode Del character '\u2421' to clear a remark
+// or a slice with the single Del string to clear labels.
+type MsgSetContact struct {
+	// User ID of the contact being updated
+	User string `json:"user"`
+	// Private alias of the contact
+	Remark string `json:"remark,omitempty"`
+	// Custom groups of the contact
+	Labels []string `json:"labels,omitempty"`
+	// Favorite flag
+	Starred *bool `json:"starred,omitempty"`
+}
+
+// MsgSetBlock is a request to change user's block list.
+type MsgSetBlock struct {
+	// Users to block
+	Add []string `json:"add,omitempty"`
+	// Users to unblock
+	Remove []string `json:"rem,omitempty"`
+}
+
+// MsgPrivacy is user's privacy settings.
+type MsgPrivacy struct {
+	// Who may send contact requests: "all", "fof" (friends of friends), "none", "verify"
+	ContactRequests string `json:"ctreq,omitempty"`
+	// Verification question and the expected answer for "verify"
+	Question string `json:"question,omitempty"`
+	Answer   string `json:"answer,omitempty"`
 }
 
 // MsgDelRange is either an individual ID (HiId=0) or a randge of deleted IDs, low end inclusive (closed),
@@ -168,6 +216,11 @@ const (
 	constMsgMetaData
 	constMsgMetaTags
 	constMsgMetaDel
+	constMsgMetaIce
+	constMsgMetaCall
+	constMsgMetaBlocked
+	constMsgMetaPrivacy
+	constMsgMetaSearch
 )
 
 const (
@@ -177,11 +230,12 @@ const (
 	constMsgDelUser
 	constMsgDelContactMsg
 	constMsgDelContact
+	constMsgDelRecall
 )
 
 func parseMsgClientMeta(params string) int {
 	var bits int
-	parts := strings.SplitN(params, " ", 8)
+	parts := strings.SplitN(params, " ", 16)
 	for _, p := range parts {
 		switch p {
 		case "desc":
@@ -198,6 +252,16 @@ func parseMsgClientMeta(params string) int {
 			bits |= constMsgMetaTags
 		case "del":
 			bits |= constMsgMetaDel
+		case "ice":
+			bits |= constMsgMetaIce
+		case "call":
+			bits |= constMsgMetaCall
+		case "blocked":
+			bits |= constMsgMetaBlocked
+		case "privacy":
+			bits |= constMsgMetaPrivacy
+		case "search":
+			bits |= constMsgMetaSearch
 		default:
 			// ignore unknown
 		}
@@ -219,6 +283,8 @@ func parseMsgClientDel(params string) int {
 		return constMsgDelSub
 	case "user":
 		return constMsgDelUser
+	case "recall":
+		return constMsgDelRecall
 	default:
 		// ignore
 	}
@@ -245,6 +311,10 @@ type MsgClientPub struct {
 	NoEcho  bool                   `json:"noecho,omitempty"`
 	Head    map[string]interface{} `json:"head,omitempty"`
 	Content interface{}            `json:"content"`
+	// SeqId of the sender's own message to replace with the new content.
+	Replace int `json:"replace,omitempty"`
+	// SeqId of the message this message replies to.
+	ReplyTo int `json:"reply,omitempty"`
 }
 
 // MsgClientGet is a query of topic state {get}.
@@ -289,7 +359,8 @@ type MsgClientDel struct {
 type MsgClientNote struct {
 	// There is no Id -- server will not akn {ping} packets, they are "fire and forget"
 	Topic string `json:"topic"`
-	// what is being reported: "recv" - message received, "read" - message read, "kp" - typing notification
+	// what is being reported: "recv" - message received, "read" - message read, "kp" - typing notification,
+	// "react", "unreact" - reaction to the message added or removed
 	What string `json:"what"`
 	// Server-issued message ID being reported
 	SeqId int `json:"seq,omitempty"`
@@ -297,6 +368,8 @@ type MsgClientNote struct {
 	ContactId string `json:"ctid,omitempty"`
 	// contact message state
 	ContactState int `json:"ctstate,omitempty"`
+	// Emoji of the reaction
+	Emoji string `json:"emoji,omitempty"`
 }
 
 type MsgClientContactMessage struct {
@@ -312,6 +385,12 @@ type MsgClientContactMessage struct {
 	ContactId string `json:"contactId"`
 	// Contact message what 'add' , 'reject', 'agree'
 	What string `json:"what"`
+	// Optional greeting sent with 'add'
+	Message string `json:"message,omitempty"`
+	// Where the receiver was found: "search", "qrcode", "card" or group topic name, optional for 'add'
+	Source string `json:"source,omitempty"`
+	// Answer to the receiver's verification question, optional for 'add'
+	Answer string `json:"answer,omitempty"`
 }
 
 type MsgClientSignal struct {
@@ -325,6 +404,10 @@ type MsgClientSignal struct {
 	Command string `json:"command"`
 	// Room id
 	Room string `json:"room"`
+	// Optional single peer user ID. If set, the signal is delivered to this user only.
+	User string `json:"user,omitempty"`
+	// Opaque payload, such as SDP offer/answer or ICE candidate.
+	Payload json.RawMessage `json:"payload,omitempty"`
 }
 
 // ClientComMessage is a wrapper for client messages.
@@ -459,15 +542,24 @@ type MsgContactMessage struct {
 	Sender   string      `json:"sender,omitempty"`
 	Receiver string      `json:"receiver,omitempty"`
 	State    int         `json:"state"`
+	Message  string      `json:"message,omitempty"`
+	Source   string      `json:"source,omitempty"`
+	Expires  *time.Time  `json:"expires,omitempty"`
 	Public   interface{} `json:"public,omitempty"`
 }
 
 // MsgContact is contact details, sent in Meta message
 type MsgContact struct {
-	Id       string      `json:"id,omitempty"`
-	CreateAt *time.Time  `json:"created,omitempty"`
+	Id       string     `json:"id,omitempty"`
+	CreateAt *time.Time `json:"created,omitempty"`
+	UpdateAt *time.Time `json:"updated,omitempty"`
+	// Set when the friendship was removed. Reported to incremental sync requests only.
+	DeleteAt *time.Time  `json:"deleted,omitempty"`
 	User     string      `json:"user,omitempty"`
 	Contact  string      `json:"contact,omitempty"`
+	Remark   string      `json:"remark,omitempty"`
+	Labels   []string    `json:"labels,omitempty"`
+	Starred  bool        `json:"starred,omitempty"`
 	Public   interface{} `json:"public,omitempty"`
 }
 
@@ -492,6 +584,35 @@ type MsgServerData struct {
 	SeqId     int                    `json:"seq"`
 	Head      map[string]interface{} `json:"head,omitempty"`
 	Content   interface{}            `json:"content"`
+	// Time of the last edit of the message, if it was edited.
+	EditedAt *time.Time `json:"edited,omitempty"`
+	// Earlier versions of the content of an edited message, oldest first.
+	Revisions []MsgRevision `json:"revisions,omitempty"`
+	// The message was recalled by the sender. Recalled messages have no content.
+	Recalled bool `json:"recalled,omitempty"`
+	// Counts of emoji reactions to the message.
+	Reactions []MsgReaction `json:"reactions,omitempty"`
+	// SeqId of the message this message replies to.
+	ReplyTo int `json:"reply,omitempty"`
+	// Number of replies to the message.
+	Replies int `json:"replies,omitempty"`
+
+	// SeqId of the message being edited. Not sent to clients.
+	replace int
+}
+
+// MsgRevision is an earlier version of the content of an edited message.
+type MsgRevision struct {
+	Timestamp time.Time   `json:"ts"`
+	Content   interface{} `json:"content"`
+}
+
+// MsgReaction is the number of users who reacted to a message with the same emoji.
+type MsgReaction struct {
+	Emoji string `json:"emoji"`
+	Count int    `json:"count"`
+	// The requesting user is one of those who reacted.
+	Mine bool `json:"mine,omitempty"`
 }
 
 // MsgServerPres is presence notification {pres} (authoritative update).
@@ -556,6 +677,59 @@ type MsgServerMeta struct {
 	ContactMsg []MsgContactMessage `json:"ctmsg,omitempty"`
 	// Contact
 	Contact []MsgContact `json:"contact,omitempty"`
+	// ICE servers for audio and video calls
+	Ice []MsgIceServer `json:"ice,omitempty"`
+	// Active group call in the topic
+	Call *MsgCallInfo `json:"call,omitempty"`
+	// Users blocked by the user, 'me' topic only
+	Blocked []string `json:"blocked,omitempty"`
+	// Privacy settings, 'me' topic only
+	Privacy *MsgPrivacy `json:"privacy,omitempty"`
+	// Messages matching a full-text search query
+	Search []MsgSearchHit `json:"search,omitempty"`
+}
+
+// MsgSearchHit is a message found by a full-text search.
+type MsgSearchHit struct {
+	// Topic of the message. The 'me' topic searches across all user's topics.
+	Topic string `json:"topic"`
+	// Message ID
+	SeqId int `json:"seq"`
+	// Sender's user ID
+	From      string    `json:"from,omitempty"`
+	Timestamp time.Time `json:"ts"`
+	// Fragment of the plain text of the message with the matched words
+	Text string `json:"txt"`
+	// Positions of the matched words in the fragment
+	Highlights []MsgHighlight `json:"hl,omitempty"`
+}
+
+// MsgHighlight is a position of a matched word in the text, in characters.
+type MsgHighlight struct {
+	At  int `json:"at"`
+	Len int `json:"len"`
+}
+
+// MsgCallInfo describes an active group call.
+type MsgCallInfo struct {
+	Room string `json:"room"`
+	// "audio" or "video"
+	Media string `json:"media"`
+	State string `json:"state"`
+	// Users currently in the room
+	Participants []string `json:"participants,omitempty"`
+	// Time when the call was started
+	Started *time.Time `json:"started,omitempty"`
+}
+
+// MsgIceServer is a STUN or TURN server description, compatible with WebRTC RTCIceServer.
+type MsgIceServer struct {
+	Urls []string `json:"urls"`
+	// TURN credentials
+	Username   string `json:"username,omitempty"`
+	Credential string `json:"credential,omitempty"`
+	// Time when the credentials expire
+	Expires *time.Time `json:"expires,omitempty"`
 }
 
 // MsgServerInfo is the server-side copy of MsgClientNote with From added (non-authoritative).
@@ -571,6 +745,8 @@ type MsgServerInfo struct {
 	ContactId string `json:"ctid,omitempty"`
 	// Contact message state
 	ContactState int `json:"ctstate,omitempty"`
+	// Emoji of the reaction
+	Emoji string `json:"emoji,omitempty"`
 }
 
 type MsgServerContact struct {
@@ -581,12 +757,22 @@ type MsgServerContact struct {
 	Receiver string `json:"receiver"`
 	// contactId
 	ContactId string `json:"contactId"`
+	// Greeting of the contact request
+	Message string `json:"message,omitempty"`
+	// Where the receiver was found
+	Source string `json:"source,omitempty"`
+
+	// Answer to the receiver's verification question. Not sent to clients.
+	answer string
 }
 
 type MsgServerSignal struct {
-	Target  string `json:"target"`
-	Command string `json:"command"`
-	Room    string `json:"room"`
+	Target  string          `json:"target"`
+	Command string          `json:"command"`
+	Room    string          `json:"room"`
+	From    string          `json:"from,omitempty"`
+	User    string          `json:"user,omitempty"`
+	Payload json.RawMessage `json:"payload,omitempty"`
 }
 
 // ServerComMessage is a wrapper for server-side messages.
@@ -45,11 +45,16 @@ type Adapter interface {
 	UserUpdateTags(uid t.Uid, tags []string, reset bool) error
 	// UserGetByCred returns user ID for the given validated credential.
 	UserGetByCred(method, value string) (t.Uid, error)
+	// UserUnreadCount returns the total number of unread messages in all topics the user is subscribed to.
+	UserUnreadCount(uid t.Uid) (int, error)
 
 	// Credential management
 
 	// CredAdd adds credential record.
 	CredAdd(cred *t.Credential) error
+	// CredUpsert adds an unconfirmed credential or, if the user already has the same credential pending,
+	// replaces its response, restarts its expiration and clears the count of failed attempts.
+	CredUpsert(cred *t.Credential) error
 	// CredGet returns credential record.
 	CredGet(uid t.Uid, method string) ([]*t.Credential, error)
 	// CredIsConfirmed returns true if the given credential has been verified, false otherwise.
@@ -61,14 +66,21 @@ type Adapter interface {
 	// CredFail increments count of failed validation attepmts for the given credentials.
 	CredFail(uid t.Uid, method string) error
 
-	//Forgot management
+	// Password recovery management
 
-	//Forget Add
+	// ForgotAdd saves a new password recovery request.
 	ForgotAdd(forgot *t.Forgot) error
-	//Forget update
-	ForgotUpd(tel string) error
-	//Forget get
-	ForgotGet(tel string) (*t.Forgot, error)
+	// ForgotGet returns the latest password recovery request for the given credential or (nil, nil) if not found.
+	ForgotGet(method, value string) (*t.Forgot, error)
+	// ForgotGetByUser returns password recovery requests of the given user which are not used yet.
+	ForgotGetByUser(uid t.Uid) ([]t.Forgot, error)
+	// ForgotUpd marks the password recovery request as used. Returns ErrNotFound if the request
+	// does not exist or is already used.
+	ForgotUpd(id string) error
+	// ForgotSetCode saves the one-time code sent for the password recovery request.
+	ForgotSetCode(id, code string) error
+	// ForgotFail increments the count of failed attempts to respond with the code of the request.
+	ForgotFail(id string) error
 
 	// Authentication management for the basic authentication scheme
 
@@ -139,24 +151,59 @@ type Adapter interface {
 	MessageSave(msg *t.Message) error
 	// MessageGetAll returns messages matching the query
 	MessageGetAll(topic string, forUser t.Uid, opts *t.QueryOpt) ([]t.Message, error)
+	// MessageGet returns the message with the given seq ID or (nil, nil) if not found. Hard-deleted
+	// messages are returned with DelId set.
+	MessageGet(topic string, seqId int) (*t.Message, error)
+	// MessageEdit replaces content of the message and appends the revision to the list of earlier versions.
+	MessageEdit(topic string, seqId int, content interface{}, rev *t.MessageRevision, editedAt time.Time) error
 	// MessageDeleteList marks messages as deleted.
 	// Soft- or Hard- is defined by forUser value: forUSer.IsZero == true is hard.
 	MessageDeleteList(topic string, toDel *t.DelMessage) error
+	// MessageReplyCounts returns the number of replies to each of the given messages which are not
+	// hard-deleted. Messages without replies are omitted.
+	MessageReplyCounts(topic string, seqIds []int) (map[int]int, error)
+	// MessageRecall hard-deletes a single message and marks it as recalled by the sender.
+	MessageRecall(topic string, toDel *t.DelMessage) error
 	// MessageGetDeleted returns a list of deleted message Ids.
 	MessageGetDeleted(topic string, forUser t.Uid, opts *t.QueryOpt) ([]t.DelMessage, error)
+	// MessageSearch returns up to limit messages within the scopes which match the full-text query,
+	// newest first. Messages deleted for the user or for everyone are skipped. Adapters without native
+	// full-text search return t.ErrUnsupported.
+	MessageSearch(forUser t.Uid, query string, scopes []t.SearchScope, limit int) ([]t.Message, error)
 	// MessageAttachments connects given message to a list of file record IDs.
 	MessageAttachments(msgId t.Uid, fids []string) error
 
+	// Reactions
+
+	// ReactionAdd saves the reaction. Returns false if the user has already reacted to the message with the emoji.
+	// Returns ErrPolicy if the user already has maxPerUser different reactions to the message or if the emoji
+	// is new to the message which already has maxPerMessage different emoji. The limits must be checked
+	// atomically with saving the reaction.
+	ReactionAdd(r *t.Reaction, maxPerUser, maxPerMessage int) (bool, error)
+	// ReactionDelete removes user's reaction to the message. Returns false if there was no such reaction.
+	ReactionDelete(topic string, seqId int, user t.Uid, emoji string) (bool, error)
+	// ReactionGetAll returns reactions to the given messages in the topic, oldest first.
+	ReactionGetAll(topic string, seqIds []int) ([]t.Reaction, error)
+
 	//ContactMessage
 
-	// ContactMessage save to database
-	ContactMessageSave(msg *t.ContactMessage) (t.Uid, error)
+	// ContactMessageCreate atomically replaces earlier requests between the two users with the sender's
+	// copy of the new request 'out' and the receiver's copy 'in'. The Id fields are set to the IDs of the
+	// saved records. Returns ErrPolicy if the receiver has a pending request to the sender.
+	ContactMessageCreate(out, in *t.ContactMessage) error
+	// ContactMessageGet returns the request from user to contact or (nil, nil) if not found
+	ContactMessageGet(user t.Uid, contact t.Uid) (*t.ContactMessage, error)
+	// ContactMessageCountPending counts user's outgoing requests which are pending and not expired at the given time
+	ContactMessageCountPending(user t.Uid, now time.Time) (int, error)
 	// ContactMessage  return matching the query
 	ContactMessageForUser(uid t.Uid, opts *t.QueryOpt) ([]t.ContactMessage, error)
-	// ContactMessage update state
-	ContactMessageUpdate(user t.Uid, contact t.Uid, state t.ContactMessageState) error
-	// ContactMessage update by Id
-	ContactMessageUpdateById(id string, state t.ContactMessageState) error
+	// ContactMessageRespond atomically accepts or rejects the request from contact to user: updates both
+	// copies of the request and, if accepted, adds the users to each other's contacts. Returns ErrNotFound
+	// if there is no such request, ErrExpired if it has expired, ErrPolicy if it has been answered already.
+	ContactMessageRespond(user t.Uid, contact t.Uid, agree bool, now time.Time) error
+	// ContactMessageUpdateById changes the state of the user's copy of the request with the given ID.
+	// Returns ErrPolicy if the current state cannot be changed to the new one.
+	ContactMessageUpdateById(user t.Uid, id string, state t.ContactMessageState) error
 	// ContactMessage delete
 	ContactMessageDelete(user t.Uid, contact t.Uid) error
 	// ContactMessage return ContactMessage
@@ -167,12 +214,34 @@ type Adapter interface {
 
 	//ContactSave save to database
 	ContactSave(contact *t.Contact) error
-	//ContactDelete delete message
+	// ContactDelete soft-deletes the friendship between the two users on both sides at once.
+	// Returns ErrNotFound if the users are not contacts.
 	ContactDelete(user t.Uid, contact t.Uid) error
-	//ContactForUser get contact by user
+	// ContactForUser returns user's contacts. If opts.IfModifiedSince is set, only contacts changed after
+	// that time are returned, including the deleted ones.
 	ContactForUser(user t.Uid, opts *t.QueryOpt) ([]t.Contact, error)
+	// ContactUpdate updates user's metadata of the contact: remark, labels, starred.
+	// Returns ErrNotFound if the users are not contacts.
+	ContactUpdate(user t.Uid, contact t.Uid, update map[string]interface{}) error
 	//ContactIsAdd return is add contact
 	ContactIsAdd(user t.Uid, contact t.Uid) (bool, error)
+	// ContactSetRevoked sets or clears the mark on the removed friendship that its removal revoked
+	// the user's permission to write into the P2P topic with the contact.
+	ContactSetRevoked(user t.Uid, contact t.Uid, revoked bool) error
+	// ContactIsRevoked checks if the removal of the friendship revoked the user's permission to write
+	// into the P2P topic with the contact.
+	ContactIsRevoked(user t.Uid, contact t.Uid) (bool, error)
+
+	// Block list
+
+	// BlockAdd adds target to user's block list
+	BlockAdd(user t.Uid, target t.Uid) error
+	// BlockDelete removes target from user's block list
+	BlockDelete(user t.Uid, target t.Uid) error
+	// BlockList returns users blocked by the user
+	BlockList(user t.Uid) ([]t.Uid, error)
+	// BlockIsBlocked checks if target is blocked by the user
+	BlockIsBlocked(user t.Uid, target t.Uid) (bool, error)
 
 	// Devices (for push notifications)
 
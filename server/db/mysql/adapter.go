@@ -10,10 +10,12 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	ms "github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
 	"github.com/tinode/chat/server/auth"
+	"github.com/tinode/chat/server/drafty"
 	"github.com/tinode/chat/server/store"
 	t "github.com/tinode/chat/server/store/types"
 )
@@ -195,6 +197,7 @@ func (a *adapter) CreateDb(reset bool) error {
 			useragent VARCHAR(255) DEFAULT '',
 			public    JSON,
 			tags      JSON,
+			privacy   JSON,
 			PRIMARY KEY(id),
 			INDEX users_deletedat(deletedat)
 		)`); err != nil {
@@ -214,16 +217,36 @@ func (a *adapter) CreateDb(reset bool) error {
 		return err
 	}
 
-	// forgot token
+	// Password recovery requests.
 	if _, err = tx.Exec(
 		`CREATE TABLE forgot(
-			 id INT NOT NULL AUTO_INCREMENT,
-			 createdat datetime(3) NOT NULL,
-  			 updatedat datetime(3) NOT NULL,
-  			 token binary(255) NOT NULL,
-		     tel varchar(12) COLLATE utf8mb4_unicode_ci DEFAULT NULL,
-			 done tinyint(4) NOT NULL DEFAULT '0',
-			 PRIMARY KEY (id),
+			id        BIGINT NOT NULL,
+			createdat DATETIME(3) NOT NULL,
+			updatedat DATETIME(3) NOT NULL,
+			userid    BIGINT NOT NULL,
+			method    VARCHAR(16) NOT NULL,
+			value     VARCHAR(128) NOT NULL,
+			token     VARBINARY(255) NOT NULL,
+			expiresat DATETIME(3) NOT NULL,
+			done      TINYINT NOT NULL DEFAULT 0,
+			code      VARCHAR(32) NOT NULL DEFAULT '',
+			retries   INT NOT NULL DEFAULT 0,
+			PRIMARY KEY(id),
+			INDEX forgot_method_value(method, value),
+			INDEX forgot_userid(userid)
+		)`); err != nil {
+		return err
+	}
+
+	// Users blocked by other users.
+	if _, err = tx.Exec(
+		`CREATE TABLE blocklist(
+			id        INT NOT NULL AUTO_INCREMENT,
+			createdat DATETIME(3) NOT NULL,
+			userid    BIGINT NOT NULL,
+			target    BIGINT NOT NULL,
+			PRIMARY KEY(id),
+			UNIQUE INDEX blocklist_userid_target(userid, target)
 		)`); err != nil {
 		return err
 	}
@@ -335,9 +358,16 @@ func (a *adapter) CreateDb(reset bool) error {
 			"`from`   BIGINT NOT NULL," +
 			`head     JSON,
 			content   JSON,
+			editedat  DATETIME(3),
+			revisions JSON,
+			recalled  TINYINT DEFAULT 0,
+			replyto   INT NOT NULL DEFAULT 0,
+			plaintext TEXT,
 			PRIMARY KEY(id),
 			FOREIGN KEY(topic) REFERENCES topics(name),
-			UNIQUE INDEX messages_topic_seqid(topic, seqid)
+			UNIQUE INDEX messages_topic_seqid(topic, seqid),
+			INDEX messages_topic_replyto_seqid(topic, replyto, seqid),
+			FULLTEXT INDEX messages_plaintext(plaintext) WITH PARSER ngram
 		);`); err != nil {
 		return err
 	}
@@ -351,7 +381,11 @@ func (a *adapter) CreateDb(reset bool) error {
           user bigint(20) NOT NULL,
           contact bigint(20) NOT NULL,
           state int(11) DEFAULT '0',
-          PRIMARY KEY (id)
+          message varchar(512) COLLATE utf8mb4_unicode_ci DEFAULT NULL,
+          source varchar(64) DEFAULT NULL,
+          expiresat datetime(3) DEFAULT NULL,
+          PRIMARY KEY (id),
+          INDEX contactmsg_user_contact(user, contact)
         ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
         `); err != nil {
 		return err
@@ -365,6 +399,10 @@ func (a *adapter) CreateDb(reset bool) error {
           deletedat datetime(3) DEFAULT NULL,
           user bigint(20) NOT NULL,
           contact bigint(20) NOT NULL,
+          remark varchar(255) NOT NULL DEFAULT '',
+          labels json DEFAULT NULL,
+          starred tinyint(1) NOT NULL DEFAULT 0,
+          revoked tinyint(1) NOT NULL DEFAULT 0,
           PRIMARY KEY (id)
           ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
         `); err != nil {
@@ -426,6 +464,23 @@ func (a *adapter) CreateDb(reset bool) error {
 		return err
 	}
 
+	// Emoji reactions to messages. Binary collation keeps distinct emoji distinct.
+	if _, err = tx.Exec(
+		`CREATE TABLE reactions(
+			id        INT NOT NULL AUTO_INCREMENT,
+			createdat DATETIME(3) NOT NULL,
+			topic     CHAR(25) NOT NULL,
+			seqid     INT NOT NULL,
+			userid    BIGINT NOT NULL,
+			emoji     VARCHAR(32) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+			PRIMARY KEY(id),
+			FOREIGN KEY(topic) REFERENCES topics(name),
+			UNIQUE INDEX reactions_topic_seqid_userid_emoji(topic, seqid, userid, emoji),
+			INDEX reactions_userid(userid)
+		)`); err != nil {
+		return err
+	}
+
 	// Links between uploaded files and the messages they are attached to.
 	if _, err = tx.Exec(
 		`CREATE TABLE filemsglinks(
@@ -709,11 +764,20 @@ func (a *adapter) UserDelete(uid t.Uid, hard bool) error {
 
 		// Delete topics where the user is the owner.
 
+		// Delete user's reactions to messages.
+		if _, err = tx.Exec("DELETE FROM reactions WHERE userid=?", decoded_uid); err != nil {
+			return err
+		}
+
 		// First delete all messages in those topics.
 		if _, err = tx.Exec("DELETE dellog FROM dellog LEFT JOIN topics ON topics.name=dellog.topic WHERE topics.owner=?",
 			decoded_uid); err != nil {
 			return err
 		}
+		if _, err = tx.Exec("DELETE reactions FROM reactions LEFT JOIN topics ON topics.name=reactions.topic WHERE topics.owner=?",
+			decoded_uid); err != nil {
+			return err
+		}
 		if _, err = tx.Exec("DELETE messages FROM messages LEFT JOIN topics ON topics.name=messages.topic WHERE topics.owner=?",
 			decoded_uid); err != nil {
 			return err
@@ -803,6 +867,17 @@ func (a *adapter) UserGetDisabled(since time.Time) ([]t.Uid, error) {
 	return uids, err
 }
 
+// UserUnreadCount returns the total number of unread messages in all topics the user is subscribed to.
+// Deleted subscriptions and topics are not counted.
+func (a *adapter) UserUnreadCount(uid t.Uid) (int, error) {
+	var count int
+	err := a.db.Get(&count, "SELECT IFNULL(SUM(t.seqid-s.readseqid),0) FROM subscriptions AS s "+
+		"JOIN topics AS t ON s.topic=t.name "+
+		"WHERE s.userid=? AND s.deletedat IS NULL AND t.deletedat IS NULL AND t.seqid>s.readseqid",
+		store.DecodeUid(uid))
+	return count, err
+}
+
 // UserUpdate updates user object.
 func (a *adapter) UserUpdate(uid t.Uid, update map[string]interface{}) error {
 	tx, err := a.db.Beginx()
@@ -1746,9 +1821,10 @@ func (a *adapter) FindTopics(req, opt []string) ([]t.Subscription, error) {
 // Messages
 func (a *adapter) MessageSave(msg *t.Message) error {
 	res, err := a.db.Exec(
-		"INSERT INTO messages(createdAt,updatedAt,seqid,topic,`from`,head,content) VALUES(?,?,?,?,?,?,?)",
+		"INSERT INTO messages(createdAt,updatedAt,seqid,topic,`from`,head,content,replyto,plaintext)"+
+			" VALUES(?,?,?,?,?,?,?,?,?)",
 		msg.CreatedAt, msg.UpdatedAt, msg.SeqId, msg.Topic,
-		store.DecodeUid(t.ParseUid(msg.From)), msg.Head, toJSON(msg.Content))
+		store.DecodeUid(t.ParseUid(msg.From)), msg.Head, toJSON(msg.Content), msg.ReplyTo, plainText(msg.Content))
 	if err == nil {
 		id, _ := res.LastInsertId()
 		msg.SetUid(t.Uid(id))
@@ -1760,7 +1836,9 @@ func (a *adapter) MessageGetAll(topic string, forUser t.Uid, opts *t.QueryOpt) (
 	var limit = maxResults // TODO(gene): pass into adapter as a config param
 	var lower = 0
 	var upper = 1 << 31
+	var thread string
 
+	args := []interface{}{store.DecodeUid(forUser), topic}
 	if opts != nil {
 		if opts.Since > 0 {
 			lower = opts.Since
@@ -1773,16 +1851,25 @@ func (a *adapter) MessageGetAll(topic string, forUser t.Uid, opts *t.QueryOpt) (
 		if opts.Limit > 0 && opts.Limit < limit {
 			limit = opts.Limit
 		}
+
+		if opts.Thread > 0 {
+			thread = " AND m.replyto=?"
+			args = append(args, opts.Thread)
+		}
 	}
+	args = append(args, lower, upper, limit)
 
-	unum := store.DecodeUid(forUser)
 	rows, err := a.db.Queryx(
-		"SELECT m.createdat,m.updatedat,m.deletedat,m.delid,m.seqid,m.topic,m.`from`,m.head,m.content"+
+		"SELECT m.createdat,m.updatedat,m.deletedat,m.delid,m.seqid,m.topic,m.`from`,"+
+			"IFNULL(m.head,'null') AS head,m.content,m.editedat,IFNULL(m.revisions,'[]') AS revisions,"+
+			"m.recalled,m.replyto"+
 			" FROM messages AS m LEFT JOIN dellog AS d"+
 			" ON d.topic=m.topic AND m.seqid BETWEEN d.low AND d.hi AND d.deletedfor=?"+
-			" WHERE m.delid=0 AND m.topic=? AND m.seqid BETWEEN ? AND ? AND d.deletedfor IS NULL"+
+			// Recalled messages are returned as tombstones.
+			" WHERE (m.delid=0 OR m.recalled=1) AND m.topic=?"+thread+
+			" AND m.seqid BETWEEN ? AND ? AND d.deletedfor IS NULL"+
 			" ORDER BY m.seqid DESC LIMIT ?",
-		unum, topic, lower, upper, limit)
+		args...)
 
 	if err != nil {
 		return nil, err
@@ -1802,6 +1889,40 @@ func (a *adapter) MessageGetAll(topic string, forUser t.Uid, opts *t.QueryOpt) (
 	return msgs, err
 }
 
+// MessageGet returns the message with the given seq ID or (nil, nil) if not found.
+func (a *adapter) MessageGet(topic string, seqId int) (*t.Message, error) {
+	var msg t.Message
+	err := a.db.Get(&msg, "SELECT createdat,updatedat,deletedat,delid,seqid,topic,`from`,"+
+		"IFNULL(head,'null') AS head,content,editedat,IFNULL(revisions,'[]') AS revisions,recalled,replyto"+
+		" FROM messages WHERE topic=? AND seqid=?", topic, seqId)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	msg.From = encodeUidString(msg.From).String()
+	msg.Content = fromJSON(msg.Content)
+	return &msg, nil
+}
+
+// MessageEdit replaces content of the message and appends the revision to the list of earlier versions.
+func (a *adapter) MessageEdit(topic string, seqId int, content interface{}, rev *t.MessageRevision,
+	editedAt time.Time) error {
+
+	res, err := a.db.Exec("UPDATE messages SET updatedat=?,editedat=?,content=?,plaintext=?,"+
+		"revisions=JSON_ARRAY_APPEND(IFNULL(revisions,JSON_ARRAY()),'$',CAST(? AS JSON))"+
+		" WHERE topic=? AND seqid=? AND delid=0",
+		editedAt, editedAt, toJSON(content), plainText(content), string(toJSON(rev)), topic, seqId)
+	if err != nil {
+		return err
+	}
+	if count, _ := res.RowsAffected(); count == 0 {
+		return t.ErrNotFound
+	}
+	return nil
+}
+
 var dellog struct {
 	Topic      string
 	Deletedfor int64
@@ -1810,6 +1931,58 @@ var dellog struct {
 	Hi         int
 }
 
+// MessageSearch finds messages using the FULLTEXT index on plain text of message content.
+func (a *adapter) MessageSearch(forUser t.Uid, query string, scopes []t.SearchScope, limit int) ([]t.Message, error) {
+	terms := fulltextQuery(query)
+	if terms == "" || len(scopes) == 0 {
+		return nil, nil
+	}
+
+	if limit <= 0 || limit > maxResults {
+		limit = maxResults
+	}
+
+	args := []interface{}{store.DecodeUid(forUser), terms}
+	where := make([]string, len(scopes))
+	for i, scope := range scopes {
+		var upper = 1 << 31
+		if scope.Before > 0 {
+			// MySQL BETWEEN is inclusive-inclusive, Tinode API requires inclusive-exclusive, thus -1
+			upper = scope.Before - 1
+		}
+		where[i] = "(m.topic=? AND m.seqid BETWEEN ? AND ?)"
+		args = append(args, scope.Topic, scope.Since, upper)
+	}
+	args = append(args, limit)
+
+	rows, err := a.db.Queryx(
+		"SELECT m.createdat,m.updatedat,m.deletedat,m.delid,m.seqid,m.topic,m.`from`,"+
+			"IFNULL(m.head,'null') AS head,m.content,m.editedat,IFNULL(m.revisions,'[]') AS revisions,"+
+			"m.recalled,m.replyto"+
+			" FROM messages AS m LEFT JOIN dellog AS d"+
+			" ON d.topic=m.topic AND m.seqid>=d.low AND m.seqid<d.hi AND d.deletedfor=?"+
+			" WHERE m.delid=0 AND MATCH(m.plaintext) AGAINST(? IN BOOLEAN MODE)"+
+			" AND ("+strings.Join(where, " OR ")+") AND d.deletedfor IS NULL"+
+			" ORDER BY m.createdat DESC LIMIT ?",
+		args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []t.Message
+	var msg t.Message
+	for rows.Next() {
+		if err = rows.StructScan(&msg); err != nil {
+			break
+		}
+		msg.From = encodeUidString(msg.From).String()
+		msg.Content = fromJSON(msg.Content)
+		msgs = append(msgs, msg)
+	}
+	rows.Close()
+	return msgs, err
+}
+
 // Get ranges of deleted messages
 func (a *adapter) MessageGetDeleted(topic string, forUser t.Uid, opts *t.QueryOpt) ([]t.DelMessage, error) {
 	var limit = maxResults
@@ -1876,6 +2049,9 @@ func messageDeleteList(tx *sqlx.Tx, topic string, toDel *t.DelMessage) error {
 	if toDel == nil {
 		// Whole topic is being deleted, thus also deleting all messages.
 		_, err = tx.Exec("DELETE FROM dellog WHERE topic=?", topic)
+		if err == nil {
+			_, err = tx.Exec("DELETE FROM reactions WHERE topic=?", topic)
+		}
 		if err == nil {
 			_, err = tx.Exec("DELETE FROM messages WHERE topic=?", topic)
 		}
@@ -1934,7 +2110,14 @@ func messageDeleteList(tx *sqlx.Tx, topic string, toDel *t.DelMessage) error {
 				return err
 			}
 
-			_, err = tx.Exec("UPDATE messages AS m SET m.deletedAt=?,m.delId=?,m.head=NULL,m.content=NULL WHERE "+
+			_, err = tx.Exec("DELETE r.* FROM reactions AS r INNER JOIN messages AS m"+
+				" ON m.topic=r.topic AND m.seqid=r.seqid WHERE "+where, args...)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.Exec("UPDATE messages AS m SET m.deletedAt=?,m.delId=?,m.head=NULL,m.content=NULL,"+
+				"m.revisions=NULL,m.plaintext=NULL WHERE "+
 				where,
 				append([]interface{}{t.TimeNow(), toDel.DelId}, args...)...)
 		}
@@ -1963,6 +2146,64 @@ func (a *adapter) MessageDeleteList(topic string, toDel *t.DelMessage) (err erro
 	return tx.Commit()
 }
 
+// MessageReplyCounts returns the number of replies to each of the given messages which are not
+// hard-deleted. Messages without replies are omitted.
+func (a *adapter) MessageReplyCounts(topic string, seqIds []int) (map[int]int, error) {
+	if len(seqIds) == 0 {
+		return nil, nil
+	}
+
+	args := []interface{}{topic}
+	for _, seq := range seqIds {
+		args = append(args, seq)
+	}
+	rows, err := a.db.Query("SELECT replyto,COUNT(*) FROM messages"+
+		" WHERE topic=? AND replyto IN (?"+strings.Repeat(",?", len(seqIds)-1)+") AND delid=0"+
+		" GROUP BY replyto", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int]int)
+	for rows.Next() {
+		var seq, count int
+		if err = rows.Scan(&seq, &count); err != nil {
+			break
+		}
+		counts[seq] = count
+	}
+	if err == nil {
+		err = rows.Err()
+	}
+	rows.Close()
+	return counts, err
+}
+
+// MessageRecall hard-deletes a single message and marks it as recalled by the sender.
+func (a *adapter) MessageRecall(topic string, toDel *t.DelMessage) (err error) {
+	tx, err := a.db.Beginx()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = messageDeleteList(tx, topic, toDel); err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec("UPDATE messages SET recalled=1 WHERE topic=? AND seqid=?",
+		topic, toDel.SeqIdRanges[0].Low); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 // MessageAttachments connects given message to a list of file record IDs.
 func (a *adapter) MessageAttachments(msgId t.Uid, fids []string) error {
 	var args []interface{}
@@ -2006,20 +2247,181 @@ func (a *adapter) MessageAttachments(msgId t.Uid, fids []string) error {
 	return tx.Commit()
 }
 
-func (a *adapter) ContactMessageSave(msg *t.ContactMessage) (t.Uid, error) {
-	result := a.db.MustExec("INSERT INTO contactmsg(createdAt,updatedAt,user,contact,state)"+
-		" VALUES(?,?,?,?,?)",
-		msg.CreatedAt, msg.UpdatedAt,
-		store.DecodeUid(t.ParseUid(msg.User)),
-		store.DecodeUid(t.ParseUid(msg.Contact)),
-		msg.State)
-	id, err := result.LastInsertId()
+// ReactionAdd saves the reaction. Returns false if the user has already reacted to the message with the emoji.
+// Returns ErrPolicy if the reaction exceeds the limits. The message is locked while the limits are checked.
+func (a *adapter) ReactionAdd(r *t.Reaction, maxPerUser, maxPerMessage int) (bool, error) {
+	tx, err := a.db.Beginx()
+	if err != nil {
+		return false, err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// Concurrent reactions to the same message wait here until the transaction is finished.
+	var msgId int
+	if err = tx.Get(&msgId, "SELECT id FROM messages WHERE topic=? AND seqid=? FOR UPDATE",
+		r.Topic, r.SeqId); err != nil {
+		if err == sql.ErrNoRows {
+			err = t.ErrNotFound
+		}
+		return false, err
+	}
+
+	userId := decodeUidString(r.User)
+	var counts struct {
+		Emojis int
+		Mine   int
+		Dupe   int
+		Used   int
+	}
+	if err = tx.Get(&counts, "SELECT COUNT(DISTINCT emoji) AS emojis,"+
+		"COALESCE(SUM(userid=?),0) AS mine,"+
+		"COALESCE(SUM(userid=? AND emoji=?),0) AS dupe,"+
+		"COALESCE(SUM(emoji=?),0) AS used "+
+		"FROM reactions WHERE topic=? AND seqid=?",
+		userId, userId, r.Emoji, r.Emoji, r.Topic, r.SeqId); err != nil {
+		return false, err
+	}
+	if counts.Dupe > 0 {
+		err = tx.Commit()
+		return false, err
+	}
+	if counts.Mine >= maxPerUser || (counts.Used == 0 && counts.Emojis >= maxPerMessage) {
+		err = t.ErrPolicy
+		return false, err
+	}
+
+	if _, err = tx.Exec("INSERT INTO reactions(createdat,topic,seqid,userid,emoji) VALUES(?,?,?,?,?)",
+		r.CreatedAt, r.Topic, r.SeqId, userId, r.Emoji); err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	return err == nil, err
+}
+
+// ReactionDelete removes user's reaction to the message. Returns false if there was no such reaction.
+func (a *adapter) ReactionDelete(topic string, seqId int, user t.Uid, emoji string) (bool, error) {
+	res, err := a.db.Exec("DELETE FROM reactions WHERE topic=? AND seqid=? AND userid=? AND emoji=?",
+		topic, seqId, store.DecodeUid(user), emoji)
+	if err != nil {
+		return false, err
+	}
+	count, err := res.RowsAffected()
+	return count > 0, err
+}
+
+// ReactionGetAll returns reactions to the given messages in the topic, oldest first.
+func (a *adapter) ReactionGetAll(topic string, seqIds []int) ([]t.Reaction, error) {
+	if len(seqIds) == 0 {
+		return nil, nil
+	}
+
+	args := []interface{}{topic}
+	for _, seq := range seqIds {
+		args = append(args, seq)
+	}
+	var reactions []t.Reaction
+	if err := a.db.Select(&reactions, "SELECT createdat,topic,seqid,userid AS user,emoji FROM reactions"+
+		" WHERE topic=? AND seqid IN (?"+strings.Repeat(",?", len(seqIds)-1)+") ORDER BY id", args...); err != nil {
+		return nil, err
+	}
+	for i := range reactions {
+		reactions[i].User = encodeUidString(reactions[i].User).String()
+	}
+	return reactions, nil
+}
+
+// ContactMessageCreate saves both copies of a new contact request in one transaction.
+func (a *adapter) ContactMessageCreate(out, in *t.ContactMessage) error {
+	tx, err := a.db.Beginx()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	user := store.DecodeUid(t.ParseUid(out.User))
+	contact := store.DecodeUid(t.ParseUid(out.Contact))
+
+	// Lock all requests between the two users. The sender's copies of a request from the receiver
+	// are in BeAdded* states.
+	var pending []t.ContactMessage
+	if err = tx.Select(&pending, "SELECT id,state,expiresat FROM contactmsg "+
+		"WHERE ((user=? AND contact=?) OR (user=? AND contact=?)) AND deletedat IS NULL FOR UPDATE",
+		user, contact, contact, user); err != nil {
+		return err
+	}
+	for i := range pending {
+		state := t.ContactMessageState(pending[i].State)
+		if (state == t.BeAddedUnread || state == t.BeAdded) && !pending[i].IsExpired(out.CreatedAt) {
+			err = t.ErrPolicy
+			return err
+		}
+	}
+
+	if _, err = tx.Exec("DELETE FROM contactmsg WHERE (user=? AND contact=?) OR (user=? AND contact=?)",
+		user, contact, contact, user); err != nil {
+		return err
+	}
+
+	for _, msg := range []*t.ContactMessage{out, in} {
+		var res sql.Result
+		res, err = tx.Exec("INSERT INTO contactmsg(createdAt,updatedAt,user,contact,state,message,source,expiresat)"+
+			" VALUES(?,?,?,?,?,?,?,?)",
+			msg.CreatedAt, msg.UpdatedAt,
+			store.DecodeUid(t.ParseUid(msg.User)),
+			store.DecodeUid(t.ParseUid(msg.Contact)),
+			msg.State, msg.Message, msg.Source, msg.ExpiresAt)
+		if err != nil {
+			return err
+		}
+		var id int64
+		if id, err = res.LastInsertId(); err != nil {
+			return err
+		}
+		msg.Id = strconv.FormatInt(id, 10)
+	}
+
+	return tx.Commit()
+}
+
+func (a *adapter) ContactMessageGet(user t.Uid, contact t.Uid) (*t.ContactMessage, error) {
+	var msg t.ContactMessage
+	err := a.db.Get(&msg, "SELECT id,createdat,updatedat,user,contact,state,"+
+		"IFNULL(message,'') AS message,IFNULL(source,'') AS source,expiresat "+
+		"FROM contactmsg WHERE user=? AND contact=? AND deletedat IS NULL ORDER BY id DESC LIMIT 1",
+		store.DecodeUid(user), store.DecodeUid(contact))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	msg.User = user.String()
+	msg.Contact = contact.String()
+	return &msg, nil
+}
 
-	return t.Uid(id), err
+func (a *adapter) ContactMessageCountPending(user t.Uid, now time.Time) (int, error) {
+	var count int
+	err := a.db.Get(&count, "SELECT COUNT(*) FROM contactmsg WHERE user=? AND state=? AND deletedat IS NULL "+
+		"AND (expiresat IS NULL OR expiresat>?)",
+		store.DecodeUid(user), t.Add, now)
+	return count, err
 }
 
 func (a *adapter) ContactMessageForUser(uid t.Uid, opts *t.QueryOpt) ([]t.ContactMessage, error) {
-	query := "select cm.id,cm.createdat,cm.user,cm.contact,cm.state,u.public " +
+	query := "select cm.id,cm.createdat,cm.user,cm.contact,cm.state,IFNULL(cm.message,'') as message," +
+		"IFNULL(cm.source,'') as source,cm.expiresat,u.public " +
 		"from contactmsg as cm left join users as u on cm.contact=u.id where cm.user=?" +
 		" and cm.deletedAt IS NULL and cm.id BETWEEN ? and ? limit ?"
 
@@ -2062,20 +2464,96 @@ func (a *adapter) ContactMessageForUser(uid t.Uid, opts *t.QueryOpt) ([]t.Contac
 	return result, err
 }
 
-func (a *adapter) ContactMessageUpdate(user t.Uid, contact t.Uid, state t.ContactMessageState) error {
-	_, err := a.db.Exec("UPDATE contactmsg SET state=? WHERE user=? AND contact=?",
-		state,
-		store.DecodeUid(user),
-		store.DecodeUid(contact),
-	)
-	return err
+// ContactMessageRespond updates both copies of the request and adds the contacts in one transaction.
+func (a *adapter) ContactMessageRespond(user t.Uid, contact t.Uid, agree bool, now time.Time) error {
+	tx, err := a.db.Beginx()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	decodedUser, decodedContact := store.DecodeUid(user), store.DecodeUid(contact)
+
+	// The receiver's and the sender's copies of the latest request.
+	var own, peer t.ContactMessage
+	query := "SELECT id,state,expiresat FROM contactmsg WHERE user=? AND contact=? AND deletedat IS NULL " +
+		"ORDER BY id DESC LIMIT 1 FOR UPDATE"
+	if err = tx.Get(&own, query, decodedUser, decodedContact); err == nil {
+		err = tx.Get(&peer, query, decodedContact, decodedUser)
+	}
+	if err == sql.ErrNoRows {
+		err = t.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	ownState, peerState := t.Reject, t.BeRejectedUnread
+	if agree {
+		ownState, peerState = t.Agree, t.Agree
+	}
+	if !t.ContactMessageState(own.State).CanChangeTo(ownState) ||
+		!t.ContactMessageState(peer.State).CanChangeTo(peerState) {
+		err = t.ErrPolicy
+		return err
+	}
+	if own.IsExpired(now) {
+		err = t.ErrExpired
+		return err
+	}
+
+	if _, err = tx.Exec("UPDATE contactmsg SET state=?,updatedat=? WHERE id=?", ownState, now, own.Id); err != nil {
+		return err
+	}
+	if _, err = tx.Exec("UPDATE contactmsg SET state=?,updatedat=? WHERE id=?", peerState, now, peer.Id); err != nil {
+		return err
+	}
+
+	if agree {
+		for _, pair := range [][2]int64{{decodedUser, decodedContact}, {decodedContact, decodedUser}} {
+			if _, err = tx.Exec("INSERT INTO contact(createdat,updatedat,user,contact) SELECT ?,?,?,? FROM DUAL "+
+				"WHERE NOT EXISTS (SELECT id FROM contact WHERE user=? AND contact=? AND deletedat IS NULL)",
+				now, now, pair[0], pair[1], pair[0], pair[1]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
 }
 
-func (a *adapter) ContactMessageUpdateById(id string, state t.ContactMessageState) error {
-	_, err := a.db.Exec("UPDATE contactmsg SET state=? WHERE id=?",
-		state,
-		id,
-	)
+// ContactMessageUpdateById changes the state of the user's copy of the request if the transition is valid.
+func (a *adapter) ContactMessageUpdateById(user t.Uid, id string, state t.ContactMessageState) error {
+	prev := state.PrevStates()
+	if len(prev) == 0 {
+		return t.ErrPolicy
+	}
+
+	args := []interface{}{state, t.TimeNow(), id, store.DecodeUid(user)}
+	for _, s := range prev {
+		args = append(args, s)
+	}
+	res, err := a.db.Exec("UPDATE contactmsg SET state=?,updatedat=? WHERE id=? AND user=? AND deletedat IS NULL "+
+		"AND state IN (?"+strings.Repeat(",?", len(prev)-1)+")", args...)
+	if err != nil {
+		return err
+	}
+	if count, _ := res.RowsAffected(); count == 0 {
+		var found int
+		err = a.db.Get(&found, "SELECT COUNT(*) FROM contactmsg WHERE id=? AND user=? AND deletedat IS NULL",
+			id, store.DecodeUid(user))
+		if err == nil {
+			err = t.ErrNotFound
+			if found > 0 {
+				err = t.ErrPolicy
+			}
+		}
+	}
 	return err
 }
 
@@ -2109,26 +2587,34 @@ func (a *adapter) ContactSave(contact *t.Contact) error {
 	return err
 }
 
-//ContactDelete delete message by id
+// ContactDelete soft-deletes both sides of the friendship in one statement.
 func (a *adapter) ContactDelete(user t.Uid, contact t.Uid) error {
 	now := t.TimeNow()
-	_, err := a.db.Exec("UPDATE contact SET updatedAt=?, deletedAt=? WHERE user=? and contact=?",
-		now, now,
-		store.DecodeUid(user),
-		store.DecodeUid(contact))
+	decodedUser, decodedContact := store.DecodeUid(user), store.DecodeUid(contact)
+	res, err := a.db.Exec("UPDATE contact SET updatedAt=?, deletedAt=? WHERE "+
+		"((user=? AND contact=?) OR (user=? AND contact=?)) AND deletedAt IS NULL",
+		now, now, decodedUser, decodedContact, decodedContact, decodedUser)
+	if err == nil {
+		if count, _ := res.RowsAffected(); count == 0 {
+			err = t.ErrNotFound
+		}
+	}
 	return err
 }
 
-//ContactForUser get contact by user
+// ContactForUser loads user's contacts. If opts.IfModifiedSince is set, contacts deleted
+// after that time are loaded too.
 func (a *adapter) ContactForUser(user t.Uid, opts *t.QueryOpt) ([]t.Contact, error) {
 
-	query := "select c.id,c.createdat,c.user,c.contact,u.public from contact" +
-		" as c left join users as u on c.contact=u.id where c.user=? " +
-		" and c.deletedAt IS NULL and c.id BETWEEN ? and ? ORDER BY c.id DESC limit ?"
+	query := "SELECT c.id,c.createdat,c.updatedat,c.deletedat,c.user,c.contact," +
+		"c.remark,IFNULL(c.labels,'[]') AS labels,c.starred,u.public FROM contact" +
+		" AS c LEFT JOIN users AS u ON c.contact=u.id WHERE c.user=?"
 
 	var limit = maxResults
 	var lower = 0
 	var upper = 1 << 31
+	var ims *time.Time
+	var label string
 
 	if opts != nil {
 		if opts.Since > 0 {
@@ -2141,9 +2627,24 @@ func (a *adapter) ContactForUser(user t.Uid, opts *t.QueryOpt) ([]t.Contact, err
 		if opts.Limit > 0 && opts.Limit < limit {
 			limit = opts.Limit
 		}
+		ims = opts.IfModifiedSince
+		label = opts.Label
+	}
+
+	args := []interface{}{store.DecodeUid(user)}
+	if ims != nil {
+		query += " AND c.updatedat>?"
+		args = append(args, ims)
+	} else {
+		query += " AND c.deletedat IS NULL"
+	}
+	if label != "" {
+		query += " AND JSON_CONTAINS(c.labels,JSON_QUOTE(?))"
+		args = append(args, label)
 	}
+	query += " AND c.id BETWEEN ? AND ? ORDER BY c.id DESC LIMIT ?"
+	args = append(args, lower, upper, limit)
 
-	args := []interface{}{store.DecodeUid(user), lower, upper, limit}
 	rows, err := a.db.Queryx(query, args...)
 	if err != nil {
 		return nil, err
@@ -2164,6 +2665,20 @@ func (a *adapter) ContactForUser(user t.Uid, opts *t.QueryOpt) ([]t.Contact, err
 	return result, err
 }
 
+// ContactUpdate updates user's metadata of the contact.
+func (a *adapter) ContactUpdate(user t.Uid, contact t.Uid, update map[string]interface{}) error {
+	cols, args := updateByMap(update)
+	args = append(args, store.DecodeUid(user), store.DecodeUid(contact))
+	res, err := a.db.Exec("UPDATE contact SET "+strings.Join(cols, ",")+
+		" WHERE user=? AND contact=? AND deletedat IS NULL", args...)
+	if err == nil {
+		if count, _ := res.RowsAffected(); count == 0 {
+			err = t.ErrNotFound
+		}
+	}
+	return err
+}
+
 func (a *adapter) ContactIsAdd(user t.Uid, contact t.Uid) (bool, error) {
 
 	var id int
@@ -2177,6 +2692,21 @@ func (a *adapter) ContactIsAdd(user t.Uid, contact t.Uid) (bool, error) {
 	return id > 0, err
 }
 
+// ContactSetRevoked sets or clears the revoked mark on the removed contacts of the user.
+func (a *adapter) ContactSetRevoked(user t.Uid, contact t.Uid, revoked bool) error {
+	_, err := a.db.Exec("UPDATE contact SET revoked=? WHERE user=? AND contact=? AND deletedat IS NOT NULL",
+		revoked, store.DecodeUid(user), store.DecodeUid(contact))
+	return err
+}
+
+// ContactIsRevoked checks if any of the removed contacts of the user has the revoked mark.
+func (a *adapter) ContactIsRevoked(user t.Uid, contact t.Uid) (bool, error) {
+	var count int
+	err := a.db.Get(&count, "SELECT COUNT(*) FROM contact WHERE user=? AND contact=? AND "+
+		"deletedat IS NOT NULL AND revoked=1", store.DecodeUid(user), store.DecodeUid(contact))
+	return count > 0, err
+}
+
 func deviceHasher(deviceID string) string {
 	// Generate custom key as [64-bit hash of device id] to ensure predictable
 	// length of the key
@@ -2186,6 +2716,52 @@ func deviceHasher(deviceID string) string {
 }
 
 // Device management for push notifications
+// Block list
+
+// BlockAdd adds target to user's block list. Blocking the same user twice is not an error.
+func (a *adapter) BlockAdd(user t.Uid, target t.Uid) error {
+	_, err := a.db.Exec("INSERT INTO blocklist(createdat,userid,target) VALUES(?,?,?)",
+		t.TimeNow(), store.DecodeUid(user), store.DecodeUid(target))
+	if isDupe(err) {
+		err = nil
+	}
+	return err
+}
+
+// BlockDelete removes target from user's block list.
+func (a *adapter) BlockDelete(user t.Uid, target t.Uid) error {
+	_, err := a.db.Exec("DELETE FROM blocklist WHERE userid=? AND target=?",
+		store.DecodeUid(user), store.DecodeUid(target))
+	return err
+}
+
+// BlockList returns users blocked by the user.
+func (a *adapter) BlockList(user t.Uid) ([]t.Uid, error) {
+	var targets []int64
+	if err := a.db.Select(&targets, "SELECT target FROM blocklist WHERE userid=? ORDER BY id",
+		store.DecodeUid(user)); err != nil {
+		return nil, err
+	}
+
+	result := make([]t.Uid, len(targets))
+	for i, target := range targets {
+		result[i] = store.EncodeUid(target)
+	}
+	return result, nil
+}
+
+// BlockIsBlocked checks if target is blocked by the user.
+func (a *adapter) BlockIsBlocked(user t.Uid, target t.Uid) (bool, error) {
+	var id int
+	err := a.db.Get(&id, "SELECT id FROM blocklist WHERE userid=? AND target=?",
+		store.DecodeUid(user), store.DecodeUid(target))
+	if err == sql.ErrNoRows {
+		// Nothing found, clear the error, otherwise it will be reported as internal error.
+		err = nil
+	}
+	return id > 0, err
+}
+
 func (a *adapter) DeviceUpsert(uid t.Uid, def *t.DeviceDef) error {
 	hash := deviceHasher(def.DeviceId)
 
@@ -2304,6 +2880,19 @@ func (a *adapter) CredAdd(cred *t.Credential) error {
 	return err
 }
 
+// CredUpsert adds an unconfirmed credential or updates the pending one.
+func (a *adapter) CredUpsert(cred *t.Credential) error {
+	res, err := a.db.Exec("UPDATE credentials SET createdat=?,updatedat=?,resp=?,retries=0 WHERE synthetic=? AND done=0",
+		cred.CreatedAt, cred.UpdatedAt, cred.Resp, cred.User+":"+cred.Method+":"+cred.Value)
+	if err != nil {
+		return err
+	}
+	if numrows, _ := res.RowsAffected(); numrows > 0 {
+		return nil
+	}
+	return a.CredAdd(cred)
+}
+
 func (a *adapter) CredIsConfirmed(uid t.Uid, method string) (bool, error) {
 	var done int
 	err := a.db.Get(&done, "SELECT done FROM credentials WHERE userid=? AND method=?",
@@ -2395,40 +2984,98 @@ func (a *adapter) CredGet(uid t.Uid, method string) ([]*t.Credential, error) {
 	return result, err
 }
 
-// forgot management
+// Password recovery management
 
-//Forget Add
+// ForgotAdd saves a new password recovery request.
 func (a *adapter) ForgotAdd(forgot *t.Forgot) error {
-	_, err := a.db.Exec("INSERT INTO forgot(createdat,updatedat,token,tel,done) "+
-		"VALUES(?,?,?,?,?)",
-		forgot.CreatedAt, forgot.UpdatedAt, forgot.Token, forgot.Tel, forgot.Done)
+	forgot.SetUid(store.GetUid())
+	_, err := a.db.Exec("INSERT INTO forgot(id,createdat,updatedat,userid,method,value,token,expiresat,done) "+
+		"VALUES(?,?,?,?,?,?,?,?,?)",
+		store.DecodeUid(forgot.Uid()), forgot.CreatedAt, forgot.UpdatedAt, decodeUidString(forgot.UserId),
+		forgot.Method, forgot.Value, forgot.Token, forgot.ExpiresAt, forgot.Done)
 	if isDupe(err) {
 		return t.ErrDuplicate
 	}
 	return err
 }
 
-//Forget update
-func (a *adapter) ForgotUpd(tel string) error {
-	_, err := a.db.Exec("UPDATE forgot SET updatedat=?,done=1 WHERE tel=?",
-		t.TimeNow(), tel)
+// forgotFields are the columns of the forgot table read into t.Forgot.
+const forgotFields = "id,createdat,updatedat,userid,method,value,token,expiresat,done,code,retries"
 
-	return err
+// forgotScan reads a row of the forgot table.
+func forgotScan(rows *sqlx.Rows) (*t.Forgot, error) {
+	var forgot t.Forgot
+	var id, userId int64
+	if err := rows.Scan(&id, &forgot.CreatedAt, &forgot.UpdatedAt, &userId,
+		&forgot.Method, &forgot.Value, &forgot.Token, &forgot.ExpiresAt, &forgot.Done,
+		&forgot.Code, &forgot.Retries); err != nil {
+		return nil, err
+	}
+	forgot.SetUid(store.EncodeUid(id))
+	forgot.UserId = store.EncodeUid(userId).String()
+	return &forgot, nil
 }
 
-//Forget get
-func (a *adapter) ForgotGet(tel string) (*t.Forgot, error) {
-	query := "select * from forgot where tel=?"
+// ForgotGet returns the latest password recovery request for the given credential or (nil, nil) if not found.
+func (a *adapter) ForgotGet(method, value string) (*t.Forgot, error) {
+	rows, err := a.db.Queryx("SELECT "+forgotFields+" FROM forgot WHERE method=? AND value=? "+
+		"ORDER BY createdat DESC LIMIT 1", method, value)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
-	var forgot t.Forgot
-	err := a.db.Get(&forgot, query, tel)
-	if err == sql.ErrNoRows {
-		return nil, nil
+	if !rows.Next() {
+		return nil, rows.Err()
 	}
+	return forgotScan(rows)
+}
+
+// ForgotGetByUser returns password recovery requests of the given user which are not used yet.
+func (a *adapter) ForgotGetByUser(uid t.Uid) ([]t.Forgot, error) {
+	rows, err := a.db.Queryx("SELECT "+forgotFields+" FROM forgot WHERE userid=? AND done=0",
+		store.DecodeUid(uid))
 	if err != nil {
-		return nil, nil
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []t.Forgot
+	for rows.Next() {
+		forgot, err := forgotScan(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *forgot)
 	}
-	return &forgot,nil
+	return result, rows.Err()
+}
+
+// ForgotUpd marks the password recovery request as used.
+func (a *adapter) ForgotUpd(id string) error {
+	res, err := a.db.Exec("UPDATE forgot SET updatedat=?,done=1 WHERE id=? AND done=0",
+		t.TimeNow(), store.DecodeUid(t.ParseUid(id)))
+	if err != nil {
+		return err
+	}
+	if numrows, _ := res.RowsAffected(); numrows < 1 {
+		return t.ErrNotFound
+	}
+	return nil
+}
+
+// ForgotSetCode saves the one-time code sent for the password recovery request.
+func (a *adapter) ForgotSetCode(id, code string) error {
+	_, err := a.db.Exec("UPDATE forgot SET updatedat=?,code=?,retries=0 WHERE id=?",
+		t.TimeNow(), code, store.DecodeUid(t.ParseUid(id)))
+	return err
+}
+
+// ForgotFail increments the count of failed attempts to respond with the code.
+func (a *adapter) ForgotFail(id string) error {
+	_, err := a.db.Exec("UPDATE forgot SET updatedat=?,retries=retries+1 WHERE id=?",
+		t.TimeNow(), store.DecodeUid(t.ParseUid(id)))
+	return err
 }
 
 // FileUploads
@@ -2580,6 +3227,28 @@ func toJSON(src interface{}) []byte {
 	return jval
 }
 
+// Plain text of message content for the FULLTEXT index. Content which cannot be converted
+// to plain text is not indexed.
+func plainText(content interface{}) interface{} {
+	txt, err := drafty.ToPlainText(content)
+	if err != nil || txt == "" {
+		return nil
+	}
+	return txt
+}
+
+// Convert user's search query into a boolean mode FULLTEXT query which requires all the words.
+// Operators and other punctuation are dropped.
+func fulltextQuery(query string) string {
+	words := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, w := range words {
+		words[i] = "+" + w
+	}
+	return strings.Join(words, " ")
+}
+
 // Deserialize JSON data from DB.
 func fromJSON(src interface{}) interface{} {
 	if src == nil {
@@ -24,6 +24,7 @@ CREATE TABLE users(
 	useragent 	VARCHAR(255) DEFAULT '',
 	public 		JSON,
 	tags		JSON, -- Denormalized array of tags
+	privacy		JSON,
 	
 	PRIMARY KEY(id),
 	INDEX users_deletedat(deletedat)
@@ -137,10 +138,50 @@ CREATE TABLE messages(
 	`from` 		BIGINT NOT NULL,
 	head 		JSON,
 	content 	JSON,
+	editedat	DATETIME(3),
+	revisions	JSON,
+	recalled	TINYINT DEFAULT 0,
+	replyto		INT NOT NULL DEFAULT 0,
+	plaintext	TEXT,
 	
 	PRIMARY KEY(id),
 	FOREIGN KEY(topic) REFERENCES topics(name),
-	UNIQUE INDEX messages_topic_seqid (topic, seqid)
+	UNIQUE INDEX messages_topic_seqid (topic, seqid),
+	INDEX messages_topic_replyto_seqid(topic, replyto, seqid),
+	FULLTEXT INDEX messages_plaintext(plaintext) WITH PARSER ngram
+);
+
+# Contact requests. Every request is stored twice: for the sender and for the receiver.
+CREATE TABLE contactmsg(
+	id			INT NOT NULL AUTO_INCREMENT,
+	createdat	DATETIME(3) NOT NULL,
+	updatedat	DATETIME(3) NOT NULL,
+	deletedat	DATETIME(3),
+	user		BIGINT NOT NULL,
+	contact		BIGINT NOT NULL,
+	state		INT DEFAULT 0,
+	message		VARCHAR(512) COLLATE utf8mb4_unicode_ci,
+	source		VARCHAR(64),
+	expiresat	DATETIME(3),
+
+	PRIMARY KEY(id),
+	INDEX contactmsg_user_contact(user, contact)
+);
+
+# Contact lists. Every friendship is stored twice, once for each user.
+CREATE TABLE contact(
+	id			INT NOT NULL AUTO_INCREMENT,
+	createdat	DATETIME(3) NOT NULL,
+	updatedat	DATETIME(3) NOT NULL,
+	deletedat	DATETIME(3),
+	user		BIGINT NOT NULL,
+	contact		BIGINT NOT NULL,
+	remark		VARCHAR(255) NOT NULL DEFAULT '',
+	labels		JSON,
+	starred		TINYINT(1) NOT NULL DEFAULT 0,
+	revoked		TINYINT(1) NOT NULL DEFAULT 0,
+
+	PRIMARY KEY(id)
 );
 
 # Deletion log
@@ -180,6 +221,36 @@ CREATE TABLE credentials(
 	FOREIGN KEY(userid) REFERENCES users(id),
 );
 
+# Users blocked by other users.
+CREATE TABLE blocklist(
+	id			INT NOT NULL AUTO_INCREMENT,
+	createdat	DATETIME(3) NOT NULL,
+	userid		BIGINT NOT NULL,
+	target		BIGINT NOT NULL,
+
+	PRIMARY KEY(id),
+	UNIQUE INDEX blocklist_userid_target(userid, target)
+);
+
+# Password recovery requests.
+CREATE TABLE forgot(
+	id			BIGINT NOT NULL,
+	createdat	DATETIME(3) NOT NULL,
+	updatedat	DATETIME(3) NOT NULL,
+	userid		BIGINT NOT NULL,
+	method		VARCHAR(16) NOT NULL,
+	value		VARCHAR(128) NOT NULL,
+	token		VARBINARY(255) NOT NULL,
+	expiresat	DATETIME(3) NOT NULL,
+	done		TINYINT NOT NULL DEFAULT 0,
+	code		VARCHAR(32) NOT NULL DEFAULT '',
+	retries		INT NOT NULL DEFAULT 0,
+
+	PRIMARY KEY(id),
+	INDEX forgot_method_value(method, value),
+	INDEX forgot_userid(userid)
+);
+
 # Records of uploaded files. Files themselves are stored elsewhere.
 CREATE TABLE fileuploads(
 	id			BIGINT NOT NULL,
@@ -194,6 +265,21 @@ CREATE TABLE fileuploads(
 	PRIMARY KEY(id)
 );
 
+# Emoji reactions to messages. Binary collation keeps distinct emoji distinct.
+CREATE TABLE reactions(
+	id			INT NOT NULL AUTO_INCREMENT,
+	createdat	DATETIME(3) NOT NULL,
+	topic		CHAR(25) NOT NULL,
+	seqid		INT NOT NULL,
+	userid		BIGINT NOT NULL,
+	emoji		VARCHAR(32) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+
+	PRIMARY KEY(id),
+	FOREIGN KEY(topic) REFERENCES topics(name),
+	UNIQUE INDEX reactions_topic_seqid_userid_emoji(topic, seqid, userid, emoji),
+	INDEX reactions_userid(userid)
+);
+
 # Links between uploaded files and messages.
 CREATE TABLE filemsglinks(
 	id			INT NOT NULL AUTO_INCREMENT,
@@ -265,6 +265,14 @@ func (a *adapter) CreateDb(reset bool) error {
 		}).RunWrite(a.conn); err != nil {
 		return err
 	}
+	// Compound index of topic - parent seqID - seqID for selecting replies to a message. Messages which
+	// are not replies have no ReplyTo field and are not indexed.
+	if _, err := rdb.DB(a.dbName).Table("messages").IndexCreateFunc("Topic_ReplyTo_SeqId",
+		func(row rdb.Term) interface{} {
+			return []interface{}{row.Field("Topic"), row.Field("ReplyTo"), row.Field("SeqId")}
+		}).RunWrite(a.conn); err != nil {
+		return err
+	}
 	// Compound index of hard-deleted messages
 	if _, err := rdb.DB(a.dbName).Table("messages").IndexCreateFunc("Topic_DelId",
 		func(row rdb.Term) interface{} {
@@ -316,6 +324,82 @@ func (a *adapter) CreateDb(reset bool) error {
 	if _, err := rdb.DB(a.dbName).Table("fileuploads").IndexCreate("UseCount").RunWrite(a.conn); err != nil {
 		return err
 	}
+
+	// Contact requests. Each request is stored twice: once for the sender and once for the receiver.
+	if _, err := rdb.DB(a.dbName).TableCreate("contactmsg", rdb.TableCreateOpts{PrimaryKey: "Id"}).RunWrite(a.conn); err != nil {
+		return err
+	}
+	// Secondary index on contactmsg.User to fetch all requests of a user.
+	if _, err := rdb.DB(a.dbName).Table("contactmsg").IndexCreate("User").RunWrite(a.conn); err != nil {
+		return err
+	}
+	// Compound index of user - contact for finding the request between two users.
+	if _, err := rdb.DB(a.dbName).Table("contactmsg").IndexCreateFunc("User_Contact",
+		func(row rdb.Term) interface{} {
+			return []interface{}{row.Field("User"), row.Field("Contact")}
+		}).RunWrite(a.conn); err != nil {
+		return err
+	}
+
+	// Contacts (friends). Each friendship is stored twice, once for each user.
+	if _, err := rdb.DB(a.dbName).TableCreate("contact", rdb.TableCreateOpts{PrimaryKey: "Id"}).RunWrite(a.conn); err != nil {
+		return err
+	}
+	// Secondary index on contact.user to fetch user's contact list.
+	if _, err := rdb.DB(a.dbName).Table("contact").IndexCreate("user").RunWrite(a.conn); err != nil {
+		return err
+	}
+	// Compound index of user - contact for checking if two users are connected.
+	if _, err := rdb.DB(a.dbName).Table("contact").IndexCreateFunc("User_Contact",
+		func(row rdb.Term) interface{} {
+			return []interface{}{row.Field("user"), row.Field("contact")}
+		}).RunWrite(a.conn); err != nil {
+		return err
+	}
+
+	// Emoji reactions to messages, one document per message. Id is "topic:seq".
+	if _, err := rdb.DB(a.dbName).TableCreate("reactions", rdb.TableCreateOpts{PrimaryKey: "Id"}).RunWrite(a.conn); err != nil {
+		return err
+	}
+	// Compound index of topic - seq to fetch reactions to messages.
+	if _, err := rdb.DB(a.dbName).Table("reactions").IndexCreateFunc("Topic_SeqId",
+		func(row rdb.Term) interface{} {
+			return []interface{}{row.Field("Topic"), row.Field("SeqId")}
+		}).RunWrite(a.conn); err != nil {
+		return err
+	}
+	// Multi-index on users who reacted to the message to delete reactions of a user.
+	if _, err := rdb.DB(a.dbName).Table("reactions").IndexCreateFunc("User",
+		func(row rdb.Term) interface{} {
+			return row.Field("Reactions").Field("User")
+		}, rdb.IndexCreateOpts{Multi: true}).RunWrite(a.conn); err != nil {
+		return err
+	}
+
+	// Users blocked by other users. Id is "user:target".
+	if _, err := rdb.DB(a.dbName).TableCreate("blocklist", rdb.TableCreateOpts{PrimaryKey: "Id"}).RunWrite(a.conn); err != nil {
+		return err
+	}
+	// Secondary index on blocklist.User to fetch the block list of a user.
+	if _, err := rdb.DB(a.dbName).Table("blocklist").IndexCreate("User").RunWrite(a.conn); err != nil {
+		return err
+	}
+
+	// Password recovery requests for the "forgot" login scheme.
+	if _, err := rdb.DB(a.dbName).TableCreate("forgot", rdb.TableCreateOpts{PrimaryKey: "Id"}).RunWrite(a.conn); err != nil {
+		return err
+	}
+	// Compound index of method - value to find the recovery request by credential.
+	if _, err := rdb.DB(a.dbName).Table("forgot").IndexCreateFunc("Method_Value",
+		func(row rdb.Term) interface{} {
+			return []interface{}{row.Field("Method"), row.Field("Value")}
+		}).RunWrite(a.conn); err != nil {
+		return err
+	}
+	// Secondary index on forgot.UserId to find the recovery requests of the user.
+	if _, err := rdb.DB(a.dbName).Table("forgot").IndexCreate("UserId").RunWrite(a.conn); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -559,6 +643,11 @@ func (a *adapter) UserDelete(uid t.Uid, hard bool) error {
 						Update(func(fu rdb.Term) interface{} {
 							return map[string]interface{}{"UseCount": fu.Field("UseCount").Default(1).Sub(1)}
 						}),
+					// Delete reactions
+					rdb.DB(a.dbName).Table("reactions").Between(
+						[]interface{}{topic.Field("Id"), rdb.MinVal},
+						[]interface{}{topic.Field("Id"), rdb.MaxVal},
+						rdb.BetweenOpts{Index: "Topic_SeqId"}).Delete(),
 					// Delete messages
 					rdb.DB(a.dbName).Table("messages").Between(
 						[]interface{}{topic.Field("Id"), rdb.MinVal},
@@ -571,6 +660,15 @@ func (a *adapter) UserDelete(uid t.Uid, hard bool) error {
 
 		log.Println("dellog, attachment, messages delete result:", err)
 
+		// Delete user's reactions to messages.
+		if _, err = rdb.DB(a.dbName).Table("reactions").GetAllByIndex("User", uid.String()).
+			Update(func(doc rdb.Term) interface{} {
+				return map[string]interface{}{"Reactions": doc.Field("Reactions").
+					Filter(func(r rdb.Term) interface{} { return r.Field("User").Ne(uid.String()) })}
+			}).RunWrite(a.conn); err != nil {
+			return err
+		}
+
 		// And finally delete the topics.
 		// TODO: denormalize Owner into topic, add index on Owner.
 		if _, err = rdb.DB(a.dbName).Table("topics").GetAllByIndex("Owner", uid.String()).
@@ -637,6 +735,32 @@ func (a *adapter) UserGetDisabled(since time.Time) ([]t.Uid, error) {
 	return uids, nil
 }
 
+// UserUnreadCount returns the total number of unread messages in all topics the user is subscribed to.
+// Deleted subscriptions and topics are not counted.
+func (a *adapter) UserUnreadCount(uid t.Uid) (int, error) {
+	cursor, err := rdb.DB(a.dbName).Table("subscriptions").GetAllByIndex("User", uid.String()).
+		Filter(rdb.Row.HasFields("DeletedAt").Not()).
+		EqJoin("Topic", rdb.DB(a.dbName).Table("topics")).
+		Filter(rdb.Row.Field("right").HasFields("DeletedAt").Not()).
+		Map(func(row rdb.Term) interface{} {
+			return row.Field("right").Field("SeqId").Sub(row.Field("left").Field("ReadSeqId"))
+		}).
+		Filter(func(unread rdb.Term) interface{} {
+			return unread.Gt(0)
+		}).
+		Sum().Run(a.conn)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close()
+
+	var count int
+	if err = cursor.One(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 // UserUpdate updates user object.
 func (a *adapter) UserUpdate(uid t.Uid, update map[string]interface{}) error {
 	_, err := rdb.DB(a.dbName).Table("users").Get(uid.String()).Update(update).RunWrite(a.conn)
@@ -1391,16 +1515,24 @@ func (a *adapter) MessageGetAll(topic string, forUser t.Uid, opts *t.QueryOpt) (
 		}
 	}
 
-	lower = []interface{}{topic, lower}
-	upper = []interface{}{topic, upper}
+	index := "Topic_SeqId"
+	if opts != nil && opts.Thread > 0 {
+		// Replies to the given message only
+		index = "Topic_ReplyTo_SeqId"
+		lower = []interface{}{topic, opts.Thread, lower}
+		upper = []interface{}{topic, opts.Thread, upper}
+	} else {
+		lower = []interface{}{topic, lower}
+		upper = []interface{}{topic, upper}
+	}
 
 	requester := forUser.String()
 	cursor, err := rdb.DB(a.dbName).Table("messages").
-		Between(lower, upper, rdb.BetweenOpts{Index: "Topic_SeqId"}).
+		Between(lower, upper, rdb.BetweenOpts{Index: index}).
 		// Ordering by index must come before filtering
-		OrderBy(rdb.OrderByOpts{Index: rdb.Desc("Topic_SeqId")}).
-		// Skip hard-deleted messages
-		Filter(rdb.Row.HasFields("DelId").Not()).
+		OrderBy(rdb.OrderByOpts{Index: rdb.Desc(index)}).
+		// Skip hard-deleted messages except recalled ones which are returned as tombstones
+		Filter(rdb.Or(rdb.Row.HasFields("DelId").Not(), rdb.Row.Field("Recalled").Default(false))).
 		// Skip messages soft-deleted for the current user
 		Filter(func(row rdb.Term) interface{} {
 			return rdb.Not(row.Field("DeletedFor").Default([]interface{}{}).Contains(
@@ -1422,6 +1554,96 @@ func (a *adapter) MessageGetAll(topic string, forUser t.Uid, opts *t.QueryOpt) (
 	return msgs, nil
 }
 
+// MessageGet returns the message with the given seq ID or (nil, nil) if not found.
+func (a *adapter) MessageGet(topic string, seqId int) (*t.Message, error) {
+	cursor, err := rdb.DB(a.dbName).Table("messages").
+		GetAllByIndex("Topic_SeqId", []interface{}{topic, seqId}).Run(a.conn)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	if cursor.IsNil() {
+		return nil, nil
+	}
+
+	var msg t.Message
+	if err = cursor.One(&msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// MessageEdit replaces content of the message and appends the revision to the list of earlier versions.
+func (a *adapter) MessageEdit(topic string, seqId int, content interface{}, rev *t.MessageRevision,
+	editedAt time.Time) error {
+
+	res, err := rdb.DB(a.dbName).Table("messages").
+		GetAllByIndex("Topic_SeqId", []interface{}{topic, seqId}).
+		// Hard-deleted messages cannot be edited
+		Filter(rdb.Row.HasFields("DelId").Not()).
+		Update(func(msg rdb.Term) interface{} {
+			return map[string]interface{}{
+				"UpdatedAt": editedAt,
+				"EditedAt":  editedAt,
+				"Content":   content,
+				"Revisions": msg.Field("Revisions").Default([]interface{}{}).Append(rev),
+			}
+		}).RunWrite(a.conn)
+	if err != nil {
+		return err
+	}
+	if res.Replaced == 0 {
+		return t.ErrNotFound
+	}
+	return nil
+}
+
+// MessageReplyCounts returns the number of replies to each of the given messages which are not
+// hard-deleted. Messages without replies are omitted.
+func (a *adapter) MessageReplyCounts(topic string, seqIds []int) (map[int]int, error) {
+	if len(seqIds) == 0 {
+		return nil, nil
+	}
+
+	cursor, err := rdb.Expr(seqIds).Map(func(seq rdb.Term) interface{} {
+		return []interface{}{seq, rdb.DB(a.dbName).Table("messages").
+			Between([]interface{}{topic, seq, rdb.MinVal}, []interface{}{topic, seq, rdb.MaxVal},
+				rdb.BetweenOpts{Index: "Topic_ReplyTo_SeqId"}).
+			Filter(func(row rdb.Term) interface{} {
+				return row.HasFields("DelId").Not()
+			}).Count()}
+	}).Run(a.conn)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	var pairs [][]int
+	if err = cursor.All(&pairs); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int]int)
+	for _, pair := range pairs {
+		if len(pair) == 2 && pair[1] > 0 {
+			counts[pair[0]] = pair[1]
+		}
+	}
+	return counts, nil
+}
+
+// MessageRecall hard-deletes a single message and marks it as recalled by the sender. The message is
+// cleared and marked in the same update, so it's never left half-recalled.
+func (a *adapter) MessageRecall(topic string, toDel *t.DelMessage) error {
+	return a.messageDeleteList(topic, toDel, true)
+}
+
+// MessageSearch is not supported: RethinkDB has no full-text index. Use an embedded search index instead.
+func (a *adapter) MessageSearch(forUser t.Uid, query string, scopes []t.SearchScope, limit int) ([]t.Message, error) {
+	return nil, t.ErrUnsupported
+}
+
 // Get ranges of deleted messages
 func (a *adapter) MessageGetDeleted(topic string, forUser t.Uid, opts *t.QueryOpt) ([]t.DelMessage, error) {
 	var limit = maxResults
@@ -1481,6 +1703,13 @@ func (a *adapter) messagesHardDelete(topic string) error {
 		return err
 	}
 
+	if _, err = rdb.DB(a.dbName).Table("reactions").Between(
+		[]interface{}{topic, rdb.MinVal},
+		[]interface{}{topic, rdb.MaxVal},
+		rdb.BetweenOpts{Index: "Topic_SeqId"}).Delete().RunWrite(a.conn); err != nil {
+		return err
+	}
+
 	q := rdb.DB(a.dbName).Table("messages").Between(
 		[]interface{}{topic, rdb.MinVal},
 		[]interface{}{topic, rdb.MaxVal},
@@ -1497,6 +1726,12 @@ func (a *adapter) messagesHardDelete(topic string) error {
 
 // MessageDeleteList deletes messages in the given topic with seqIds from the list
 func (a *adapter) MessageDeleteList(topic string, toDel *t.DelMessage) error {
+	return a.messageDeleteList(topic, toDel, false)
+}
+
+// messageDeleteList deletes messages in the given topic with seqIds from the list. If recall is true,
+// hard-deleted messages are marked as recalled.
+func (a *adapter) messageDeleteList(topic string, toDel *t.DelMessage, recall bool) error {
 	var indexVals []interface{}
 	var err error
 
@@ -1534,13 +1769,20 @@ func (a *adapter) MessageDeleteList(topic string, toDel *t.DelMessage) error {
 		// Skip already hard-deleted messages.
 		query = query.Filter(rdb.Row.HasFields("DelId").Not())
 		if toDel.DeletedFor == "" {
-			// First decrement use counter for attachments.
+			// First decrement use counter for attachments and delete reactions.
 			if err = a.fileDecrementUseCounter(query); err == nil {
+				err = a.reactionsDelete(query)
+			}
+			if err == nil {
 				// Hard-delete individual messages. Message is not deleted but all fields with content
 				// are replaced with nulls.
-				_, err = query.Update(map[string]interface{}{
+				update := map[string]interface{}{
 					"DeletedAt": t.TimeNow(), "DelId": toDel.DelId, "From": nil,
-					"Head": nil, "Content": nil, "Attachments": nil}).RunWrite(a.conn)
+					"Head": nil, "Content": nil, "Attachments": nil, "Revisions": nil}
+				if recall {
+					update["Recalled"] = true
+				}
+				_, err = query.Update(update).RunWrite(a.conn)
 			}
 
 		} else {
@@ -1569,6 +1811,115 @@ func (a *adapter) MessageDeleteList(topic string, toDel *t.DelMessage) error {
 	return err
 }
 
+// reactionsDelete deletes reactions to messages selected by the query.
+func (a *adapter) reactionsDelete(msgQuery rdb.Term) error {
+	_, err := rdb.DB(a.dbName).Table("reactions").GetAllByIndex("Topic_SeqId",
+		rdb.Args(
+			msgQuery.
+				Map(func(row rdb.Term) interface{} {
+					return []interface{}{row.Field("Topic"), row.Field("SeqId")}
+				}).
+				CoerceTo("array"))).
+		Delete().RunWrite(a.conn)
+	return err
+}
+
+// ReactionAdd saves the reaction. Returns false if the user has already reacted to the message with the emoji.
+// Returns ErrPolicy if the reaction exceeds the limits. All reactions to a message are kept in one document,
+// so the limits are checked and the reaction is added in one atomic update.
+func (a *adapter) ReactionAdd(r *t.Reaction, maxPerUser, maxPerMessage int) (bool, error) {
+	reaction := map[string]interface{}{"CreatedAt": r.CreatedAt, "User": r.User, "Emoji": r.Emoji}
+	id := reactionsId(r.Topic, r.SeqId)
+	res, err := rdb.DB(a.dbName).Table("reactions").Insert(map[string]interface{}{
+		"Id":        id,
+		"Topic":     r.Topic,
+		"SeqId":     r.SeqId,
+		"Reactions": []interface{}{reaction},
+	}, rdb.InsertOpts{Conflict: func(id, oldDoc, newDoc rdb.Term) interface{} {
+		reactions := oldDoc.Field("Reactions")
+		mine := reactions.Filter(map[string]interface{}{"User": r.User})
+		emojis := reactions.Field("Emoji").Distinct()
+		return rdb.Branch(
+			// Keep the original reaction.
+			mine.Field("Emoji").Contains(r.Emoji), oldDoc,
+			mine.Count().Ge(maxPerUser), oldDoc,
+			emojis.Contains(r.Emoji).Not().And(emojis.Count().Ge(maxPerMessage)), oldDoc,
+			oldDoc.Merge(map[string]interface{}{"Reactions": reactions.Append(reaction)}))
+	}}).RunWrite(a.conn)
+	if err != nil {
+		return false, err
+	}
+	if res.Inserted > 0 || res.Replaced > 0 {
+		return true, nil
+	}
+
+	// The reaction was not added: either it's a duplicate or it exceeds the limits.
+	cursor, err := rdb.DB(a.dbName).Table("reactions").Get(id).Field("Reactions").
+		Filter(map[string]interface{}{"User": r.User, "Emoji": r.Emoji}).Count().Run(a.conn)
+	if err != nil {
+		return false, err
+	}
+	defer cursor.Close()
+
+	var count int
+	if err = cursor.One(&count); err != nil {
+		return false, err
+	}
+	if count == 0 {
+		return false, t.ErrPolicy
+	}
+	return false, nil
+}
+
+// ReactionDelete removes user's reaction to the message. Returns false if there was no such reaction.
+func (a *adapter) ReactionDelete(topic string, seqId int, user t.Uid, emoji string) (bool, error) {
+	res, err := rdb.DB(a.dbName).Table("reactions").Get(reactionsId(topic, seqId)).
+		Update(func(doc rdb.Term) interface{} {
+			return map[string]interface{}{"Reactions": doc.Field("Reactions").
+				Filter(func(r rdb.Term) interface{} {
+					return r.Field("User").Ne(user.String()).Or(r.Field("Emoji").Ne(emoji))
+				})}
+		}).RunWrite(a.conn)
+	if err != nil {
+		return false, err
+	}
+	return res.Replaced > 0, nil
+}
+
+// ReactionGetAll returns reactions to the given messages in the topic, oldest first.
+func (a *adapter) ReactionGetAll(topic string, seqIds []int) ([]t.Reaction, error) {
+	if len(seqIds) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]interface{}, len(seqIds))
+	for i, seq := range seqIds {
+		ids[i] = reactionsId(topic, seq)
+	}
+	cursor, err := rdb.DB(a.dbName).Table("reactions").GetAll(ids...).
+		ConcatMap(func(doc rdb.Term) interface{} {
+			return doc.Field("Reactions").Merge(map[string]interface{}{
+				"Topic": doc.Field("Topic"),
+				"SeqId": doc.Field("SeqId")})
+		}).
+		OrderBy("CreatedAt").Run(a.conn)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	var reactions []t.Reaction
+	if err = cursor.All(&reactions); err != nil {
+		return nil, err
+	}
+	return reactions, nil
+}
+
+// reactionsId is the ID of the document with reactions to the message.
+func reactionsId(topic string, seqId int) string {
+	return topic + ":" + strconv.Itoa(seqId)
+}
+
 // MessageAttachments adds attachments to a message.
 func (a *adapter) MessageAttachments(msgId t.Uid, fids []string) error {
 	now := t.TimeNow()
@@ -1594,6 +1945,462 @@ func (a *adapter) MessageAttachments(msgId t.Uid, fids []string) error {
 	return err
 }
 
+// ContactMessageCreate saves both copies of a new contact request. RethinkDB has no multi-document
+// transactions: the copies get IDs derived from the two users so concurrent requests between the same
+// users collide, and the sender's copy is removed if the receiver's copy cannot be saved.
+func (a *adapter) ContactMessageCreate(out, in *t.ContactMessage) error {
+	between := rdb.DB(a.dbName).Table("contactmsg").
+		GetAllByIndex("User_Contact",
+			[]interface{}{out.User, out.Contact},
+			[]interface{}{out.Contact, out.User})
+
+	cursor, err := between.Filter(rdb.Row.HasFields("DeletedAt").Not()).Run(a.conn)
+	if err != nil {
+		return err
+	}
+	var existing []t.ContactMessage
+	err = cursor.All(&existing)
+	cursor.Close()
+	if err != nil {
+		return err
+	}
+	for i := range existing {
+		state := t.ContactMessageState(existing[i].State)
+		if existing[i].User == out.User && (state == t.BeAddedUnread || state == t.BeAdded) &&
+			!existing[i].IsExpired(out.CreatedAt) {
+			// The receiver has a pending request to the sender.
+			return t.ErrPolicy
+		}
+	}
+
+	if _, err = between.Delete().RunWrite(a.conn); err != nil {
+		return err
+	}
+
+	// Public is denormalized from the users table on read, don't store it.
+	out.Public, in.Public = nil, nil
+	out.Id = out.User + ":" + out.Contact
+	in.Id = in.User + ":" + in.Contact
+
+	table := rdb.DB(a.dbName).Table("contactmsg")
+	if _, err = table.Insert(out).RunWrite(a.conn); err != nil {
+		if rdb.IsConflictErr(err) {
+			err = t.ErrPolicy
+		}
+		return err
+	}
+	if _, err = table.Insert(in).RunWrite(a.conn); err != nil {
+		table.Get(out.Id).Delete().RunWrite(a.conn)
+		if rdb.IsConflictErr(err) {
+			err = t.ErrPolicy
+		}
+		return err
+	}
+	return nil
+}
+
+// ContactMessageGet returns the latest contact request from user to contact or (nil, nil) if not found.
+func (a *adapter) ContactMessageGet(user t.Uid, contact t.Uid) (*t.ContactMessage, error) {
+	cursor, err := rdb.DB(a.dbName).Table("contactmsg").
+		GetAllByIndex("User_Contact", []interface{}{user.String(), contact.String()}).
+		Filter(rdb.Row.HasFields("DeletedAt").Not()).
+		OrderBy(rdb.Desc("CreatedAt")).Limit(1).Run(a.conn)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	if cursor.IsNil() {
+		return nil, nil
+	}
+
+	var msg t.ContactMessage
+	if err = cursor.One(&msg); err != nil {
+		if err == rdb.ErrEmptyResult {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// ContactMessageCountPending counts user's outgoing contact requests which are not answered and not expired.
+func (a *adapter) ContactMessageCountPending(user t.Uid, now time.Time) (int, error) {
+	cursor, err := rdb.DB(a.dbName).Table("contactmsg").GetAllByIndex("User", user.String()).
+		Filter(func(row rdb.Term) rdb.Term {
+			return row.Field("State").Eq(t.Add).
+				And(row.HasFields("DeletedAt").Not()).
+				And(row.Field("ExpiresAt").Default(nil).Eq(nil).Or(row.Field("ExpiresAt").Gt(now)))
+		}).Count().Run(a.conn)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close()
+
+	var count int
+	if err = cursor.One(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// ContactMessageForUser loads contact requests of the given user. Public value of the other user is loaded too.
+func (a *adapter) ContactMessageForUser(uid t.Uid, opts *t.QueryOpt) ([]t.ContactMessage, error) {
+	limit := maxResults
+	if opts != nil {
+		// Since & Before are ignored: record IDs are not sequential.
+		if opts.Limit > 0 && opts.Limit < limit {
+			limit = opts.Limit
+		}
+	}
+
+	cursor, err := rdb.DB(a.dbName).Table("contactmsg").GetAllByIndex("User", uid.String()).
+		Filter(rdb.Row.HasFields("DeletedAt").Not()).
+		OrderBy(rdb.Desc("CreatedAt")).
+		Limit(limit).Run(a.conn)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	var msgs []t.ContactMessage
+	if err = cursor.All(&msgs); err != nil {
+		return nil, err
+	}
+
+	if len(msgs) == 0 {
+		return msgs, nil
+	}
+
+	usrq := make([]interface{}, 0, len(msgs))
+	for i := range msgs {
+		usrq = append(usrq, msgs[i].Contact)
+	}
+	public, err := a.usersPublic(usrq)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range msgs {
+		msgs[i].Public = public[msgs[i].Contact]
+		msgs[i].User = t.ParseUid(msgs[i].User).UserId()
+		msgs[i].Contact = t.ParseUid(msgs[i].Contact).UserId()
+	}
+
+	return msgs, nil
+}
+
+// ContactMessageRespond adds the contacts and updates both copies of the request. Each copy is changed
+// only if its state has not changed since it was read. If any step fails, the contacts added and the
+// copies changed so far are restored.
+func (a *adapter) ContactMessageRespond(user t.Uid, contact t.Uid, agree bool, now time.Time) error {
+	own, err := a.ContactMessageGet(user, contact)
+	if err != nil {
+		return err
+	}
+	peer, err := a.ContactMessageGet(contact, user)
+	if err != nil {
+		return err
+	}
+	if own == nil || peer == nil {
+		return t.ErrNotFound
+	}
+
+	ownState, peerState := t.Reject, t.BeRejectedUnread
+	if agree {
+		ownState, peerState = t.Agree, t.Agree
+	}
+	if !t.ContactMessageState(own.State).CanChangeTo(ownState) ||
+		!t.ContactMessageState(peer.State).CanChangeTo(peerState) {
+		return t.ErrPolicy
+	}
+	if own.IsExpired(now) {
+		return t.ErrExpired
+	}
+
+	// IDs of the contacts added by this call and the copy of the request changed so far.
+	var added []interface{}
+	var ownChanged bool
+	ownPrev, peerPrev := t.ContactMessageState(own.State), t.ContactMessageState(peer.State)
+	// Undoes the changes made so far. Returns the error which caused the rollback or the rollback error.
+	rollback := func(cause error) error {
+		var rerr error
+		if ownChanged {
+			_, rerr = a.contactMessageChangeState(own.Id, []t.ContactMessageState{ownState}, ownPrev, own.UpdatedAt)
+		}
+		if len(added) > 0 {
+			if _, err := rdb.DB(a.dbName).Table("contact").GetAll(added...).Delete().RunWrite(a.conn); err != nil {
+				rerr = err
+			}
+		}
+		if rerr != nil {
+			return rerr
+		}
+		return cause
+	}
+
+	if agree {
+		for _, pair := range [][2]t.Uid{{user, contact}, {contact, user}} {
+			if exists, err := a.ContactIsAdd(pair[0], pair[1]); err != nil {
+				return rollback(err)
+			} else if exists {
+				continue
+			}
+			c := t.Contact{User: pair[0].String(), Contact: pair[1].String()}
+			c.CreatedAt, c.UpdatedAt = now, now
+			if err := a.ContactSave(&c); err != nil {
+				return rollback(err)
+			}
+			added = append(added, c.Id)
+		}
+	}
+
+	if ok, err := a.contactMessageChangeState(own.Id, []t.ContactMessageState{ownPrev}, ownState, now); err != nil {
+		return rollback(err)
+	} else if !ok {
+		return rollback(t.ErrPolicy)
+	}
+	ownChanged = true
+	if ok, err := a.contactMessageChangeState(peer.Id, []t.ContactMessageState{peerPrev}, peerState, now); err != nil {
+		return rollback(err)
+	} else if !ok {
+		return rollback(t.ErrPolicy)
+	}
+	return nil
+}
+
+// ContactMessageUpdateById changes the state of the user's copy of the request if the transition is valid.
+func (a *adapter) ContactMessageUpdateById(user t.Uid, id string, state t.ContactMessageState) error {
+	cursor, err := rdb.DB(a.dbName).Table("contactmsg").Get(id).Run(a.conn)
+	if err != nil {
+		return err
+	}
+	var msg t.ContactMessage
+	err = cursor.One(&msg)
+	cursor.Close()
+	if err == rdb.ErrEmptyResult || (err == nil && (msg.User != user.String() || msg.DeletedAt != nil)) {
+		return t.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if ok, err := a.contactMessageChangeState(id, state.PrevStates(), state, t.TimeNow()); err != nil {
+		return err
+	} else if !ok {
+		return t.ErrPolicy
+	}
+	return nil
+}
+
+// contactMessageChangeState changes the state of the request to 'to' if its current state is one of 'from'.
+// Returns true if the state was changed.
+func (a *adapter) contactMessageChangeState(id string, from []t.ContactMessageState, to t.ContactMessageState,
+	now time.Time) (bool, error) {
+
+	if len(from) == 0 {
+		return false, nil
+	}
+	res, err := rdb.DB(a.dbName).Table("contactmsg").Get(id).
+		Update(func(row rdb.Term) interface{} {
+			return rdb.Branch(rdb.Expr(from).Contains(row.Field("State")),
+				map[string]interface{}{"State": to, "UpdatedAt": now},
+				map[string]interface{}{})
+		}).RunWrite(a.conn)
+	if err != nil {
+		return false, err
+	}
+	return res.Replaced > 0, nil
+}
+
+// ContactMessageDelete deletes contact request from user to contact.
+func (a *adapter) ContactMessageDelete(user t.Uid, contact t.Uid) error {
+	_, err := rdb.DB(a.dbName).Table("contactmsg").
+		GetAllByIndex("User_Contact", []interface{}{user.String(), contact.String()}).
+		Delete().RunWrite(a.conn)
+	return err
+}
+
+// ContactMessageIsAdded checks if a contact request from user to contact exists.
+func (a *adapter) ContactMessageIsAdded(user t.Uid, contact t.Uid) (bool, error) {
+	cursor, err := rdb.DB(a.dbName).Table("contactmsg").
+		GetAllByIndex("User_Contact", []interface{}{user.String(), contact.String()}).
+		Count().Run(a.conn)
+	if err != nil {
+		return false, err
+	}
+	defer cursor.Close()
+
+	var count int
+	if err = cursor.One(&count); err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// ContactSave adds contact to user's contact list.
+func (a *adapter) ContactSave(contact *t.Contact) error {
+	contact.SetUid(store.GetUid())
+	contact.Public = nil
+	_, err := rdb.DB(a.dbName).Table("contact").Insert(contact).RunWrite(a.conn)
+	return err
+}
+
+// ContactDelete marks both sides of the friendship as deleted in one query.
+func (a *adapter) ContactDelete(user t.Uid, contact t.Uid) error {
+	now := t.TimeNow()
+	res, err := rdb.DB(a.dbName).Table("contact").
+		GetAllByIndex("User_Contact",
+			[]interface{}{user.String(), contact.String()},
+			[]interface{}{contact.String(), user.String()}).
+		Filter(rdb.Row.HasFields("DeletedAt").Not()).
+		Update(map[string]interface{}{
+			"UpdatedAt": now,
+			"DeletedAt": now,
+		}).RunWrite(a.conn)
+	if err == nil && res.Replaced == 0 {
+		err = t.ErrNotFound
+	}
+	return err
+}
+
+// ContactForUser loads user's contact list. Public value of the contacts is loaded too.
+func (a *adapter) ContactForUser(user t.Uid, opts *t.QueryOpt) ([]t.Contact, error) {
+	limit := maxResults
+	var ims *time.Time
+	var label string
+	if opts != nil {
+		// Since & Before are ignored: record IDs are not sequential.
+		if opts.Limit > 0 && opts.Limit < limit {
+			limit = opts.Limit
+		}
+		ims = opts.IfModifiedSince
+		label = opts.Label
+	}
+
+	// Deleted contacts are reported to clients which sync incrementally.
+	filter := rdb.Row.HasFields("DeletedAt").Not()
+	if ims != nil {
+		filter = rdb.Row.Field("UpdatedAt").Gt(ims)
+	}
+	if label != "" {
+		filter = filter.And(rdb.Row.Field("Labels").Default([]string{}).Contains(label))
+	}
+
+	cursor, err := rdb.DB(a.dbName).Table("contact").GetAllByIndex("user", user.String()).
+		Filter(filter).
+		OrderBy(rdb.Desc("CreatedAt")).
+		Limit(limit).Run(a.conn)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	var contacts []t.Contact
+	if err = cursor.All(&contacts); err != nil {
+		return nil, err
+	}
+
+	if len(contacts) == 0 {
+		return contacts, nil
+	}
+
+	usrq := make([]interface{}, 0, len(contacts))
+	for i := range contacts {
+		usrq = append(usrq, contacts[i].Contact)
+	}
+	public, err := a.usersPublic(usrq)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range contacts {
+		contacts[i].Public = public[contacts[i].Contact]
+		contacts[i].User = t.ParseUid(contacts[i].User).UserId()
+		contacts[i].Contact = t.ParseUid(contacts[i].Contact).UserId()
+	}
+
+	return contacts, nil
+}
+
+// ContactUpdate updates user's metadata of the contact.
+func (a *adapter) ContactUpdate(user t.Uid, contact t.Uid, update map[string]interface{}) error {
+	res, err := rdb.DB(a.dbName).Table("contact").
+		GetAllByIndex("User_Contact", []interface{}{user.String(), contact.String()}).
+		Filter(rdb.Row.HasFields("DeletedAt").Not()).
+		Update(update).RunWrite(a.conn)
+	if err == nil && res.Replaced+res.Unchanged == 0 {
+		err = t.ErrNotFound
+	}
+	return err
+}
+
+// ContactIsAdd checks if contact is in user's contact list.
+func (a *adapter) ContactIsAdd(user t.Uid, contact t.Uid) (bool, error) {
+	cursor, err := rdb.DB(a.dbName).Table("contact").
+		GetAllByIndex("User_Contact", []interface{}{user.String(), contact.String()}).
+		Filter(rdb.Row.HasFields("DeletedAt").Not()).
+		Count().Run(a.conn)
+	if err != nil {
+		return false, err
+	}
+	defer cursor.Close()
+
+	var count int
+	if err = cursor.One(&count); err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// ContactSetRevoked sets or clears the revoked mark on the removed contacts of the user.
+func (a *adapter) ContactSetRevoked(user t.Uid, contact t.Uid, revoked bool) error {
+	_, err := rdb.DB(a.dbName).Table("contact").
+		GetAllByIndex("User_Contact", []interface{}{user.String(), contact.String()}).
+		Filter(rdb.Row.HasFields("DeletedAt")).
+		Update(map[string]interface{}{"Revoked": revoked}).RunWrite(a.conn)
+	return err
+}
+
+// ContactIsRevoked checks if any of the removed contacts of the user has the revoked mark.
+func (a *adapter) ContactIsRevoked(user t.Uid, contact t.Uid) (bool, error) {
+	cursor, err := rdb.DB(a.dbName).Table("contact").
+		GetAllByIndex("User_Contact", []interface{}{user.String(), contact.String()}).
+		Filter(rdb.Row.HasFields("DeletedAt").And(rdb.Row.Field("Revoked").Default(false).Eq(true))).
+		Count().Run(a.conn)
+	if err != nil {
+		return false, err
+	}
+	defer cursor.Close()
+
+	var count int
+	if err = cursor.One(&count); err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// usersPublic loads Public values of the given users. The result is keyed by user ID as stored in DB.
+func (a *adapter) usersPublic(ids []interface{}) (map[string]interface{}, error) {
+	cursor, err := rdb.DB(a.dbName).Table("users").GetAll(ids...).Pluck("Id", "Public").Run(a.conn)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	var row struct {
+		Id     string
+		Public interface{}
+	}
+	public := make(map[string]interface{}, len(ids))
+	for cursor.Next(&row) {
+		public[row.Id] = row.Public
+		row.Public = nil
+	}
+
+	return public, cursor.Err()
+}
+
 func deviceHasher(deviceID string) string {
 	// Generate custom key as [64-bit hash of device id] to ensure predictable
 	// length of the key
@@ -1602,6 +2409,58 @@ func deviceHasher(deviceID string) string {
 	return strconv.FormatUint(uint64(hasher.Sum64()), 16)
 }
 
+// Block list
+
+// BlockAdd adds target to user's block list. Blocking the same user twice is not an error.
+func (a *adapter) BlockAdd(user t.Uid, target t.Uid) error {
+	_, err := rdb.DB(a.dbName).Table("blocklist").Insert(map[string]interface{}{
+		"Id":        user.String() + ":" + target.String(),
+		"CreatedAt": t.TimeNow(),
+		"User":      user.String(),
+		"Target":    target.String(),
+	}, rdb.InsertOpts{Conflict: "replace"}).RunWrite(a.conn)
+	return err
+}
+
+// BlockDelete removes target from user's block list.
+func (a *adapter) BlockDelete(user t.Uid, target t.Uid) error {
+	_, err := rdb.DB(a.dbName).Table("blocklist").Get(user.String() + ":" + target.String()).
+		Delete().RunWrite(a.conn)
+	return err
+}
+
+// BlockList returns users blocked by the user.
+func (a *adapter) BlockList(user t.Uid) ([]t.Uid, error) {
+	cursor, err := rdb.DB(a.dbName).Table("blocklist").GetAllByIndex("User", user.String()).
+		OrderBy("CreatedAt").Field("Target").Run(a.conn)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	var targets []string
+	if err = cursor.All(&targets); err != nil {
+		return nil, err
+	}
+
+	result := make([]t.Uid, len(targets))
+	for i, target := range targets {
+		result[i] = t.ParseUid(target)
+	}
+	return result, nil
+}
+
+// BlockIsBlocked checks if target is blocked by the user.
+func (a *adapter) BlockIsBlocked(user t.Uid, target t.Uid) (bool, error) {
+	cursor, err := rdb.DB(a.dbName).Table("blocklist").Get(user.String() + ":" + target.String()).Run(a.conn)
+	if err != nil {
+		return false, err
+	}
+	defer cursor.Close()
+
+	return !cursor.IsNil(), nil
+}
+
 // Device management for push notifications
 func (a *adapter) DeviceUpsert(uid t.Uid, def *t.DeviceDef) error {
 	hash := deviceHasher(def.DeviceId)
@@ -1719,6 +2578,25 @@ func (a *adapter) CredAdd(cred *t.Credential) error {
 	return err
 }
 
+// CredUpsert adds an unconfirmed credential or updates the pending one.
+func (a *adapter) CredUpsert(cred *t.Credential) error {
+	// Only unconfirmed credentials have the user ID in the primary key.
+	res, err := rdb.DB(a.dbName).Table("credentials").Get(cred.User + ":" + cred.Method + ":" + cred.Value).
+		Update(map[string]interface{}{
+			"CreatedAt": cred.CreatedAt,
+			"UpdatedAt": cred.UpdatedAt,
+			"Resp":      cred.Resp,
+			"Retries":   0,
+		}).RunWrite(a.conn)
+	if err != nil {
+		return err
+	}
+	if res.Replaced+res.Unchanged > 0 {
+		return nil
+	}
+	return a.CredAdd(cred)
+}
+
 func (a *adapter) CredIsConfirmed(uid t.Uid, method string) (bool, error) {
 	creds, err := a.CredGet(uid, method)
 	if err != nil {
@@ -1809,6 +2687,95 @@ func (a *adapter) CredGet(uid t.Uid, method string) ([]*t.Credential, error) {
 	return result, nil
 }
 
+// Forgot management
+
+// ForgotAdd saves a password recovery request.
+func (a *adapter) ForgotAdd(forgot *t.Forgot) error {
+	forgot.SetUid(store.GetUid())
+	_, err := rdb.DB(a.dbName).Table("forgot").Insert(forgot).RunWrite(a.conn)
+	if rdb.IsConflictErr(err) {
+		return t.ErrDuplicate
+	}
+	return err
+}
+
+// ForgotGet returns the latest password recovery request for the given credential or (nil, nil) if not found.
+func (a *adapter) ForgotGet(method, value string) (*t.Forgot, error) {
+	cursor, err := rdb.DB(a.dbName).Table("forgot").GetAllByIndex("Method_Value", []string{method, value}).
+		OrderBy(rdb.Desc("CreatedAt")).Limit(1).Run(a.conn)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	if cursor.IsNil() {
+		return nil, nil
+	}
+
+	var forgot t.Forgot
+	if err = cursor.One(&forgot); err != nil {
+		if err == rdb.ErrEmptyResult {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &forgot, nil
+}
+
+// ForgotGetByUser returns password recovery requests of the given user which are not used yet.
+func (a *adapter) ForgotGetByUser(uid t.Uid) ([]t.Forgot, error) {
+	cursor, err := rdb.DB(a.dbName).Table("forgot").GetAllByIndex("UserId", uid.String()).
+		Filter(rdb.Row.Field("Done").Eq(false)).Run(a.conn)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	var result []t.Forgot
+	if err = cursor.All(&result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ForgotUpd marks the password recovery request as used.
+func (a *adapter) ForgotUpd(id string) error {
+	// Update only if not used yet, so the same request cannot be used twice.
+	res, err := rdb.DB(a.dbName).Table("forgot").Get(id).
+		Update(func(row rdb.Term) interface{} {
+			return rdb.Branch(row.Field("Done"), map[string]interface{}{},
+				map[string]interface{}{"UpdatedAt": t.TimeNow(), "Done": true})
+		}).RunWrite(a.conn)
+	if err != nil {
+		return err
+	}
+	if res.Replaced < 1 {
+		return t.ErrNotFound
+	}
+	return nil
+}
+
+// ForgotSetCode saves the one-time code sent for the password recovery request.
+func (a *adapter) ForgotSetCode(id, code string) error {
+	_, err := rdb.DB(a.dbName).Table("forgot").Get(id).
+		Update(map[string]interface{}{
+			"UpdatedAt": t.TimeNow(),
+			"Code":      code,
+			"Retries":   0,
+		}).RunWrite(a.conn)
+	return err
+}
+
+// ForgotFail increments the count of failed attempts to respond with the code.
+func (a *adapter) ForgotFail(id string) error {
+	_, err := rdb.DB(a.dbName).Table("forgot").Get(id).
+		Update(map[string]interface{}{
+			"UpdatedAt": t.TimeNow(),
+			"Retries":   rdb.Row.Field("Retries").Default(0).Add(1),
+		}).RunWrite(a.conn)
+	return err
+}
+
 // FileUploads
 
 // FileStartUpload initializes a file upload
@@ -102,8 +102,22 @@ type Hub struct {
 	// Request to shutdown, unbuffered
 	shutdown chan chan<- bool
 
+	// Processes {contact} and {signal} sent to 'me' outside of the topic
+	social *socialHandler
+
 	// Flag for indicating that system shutdown is in progress
 	isShutdownInProgress bool
+
+	// Per-topic locks which serialize loading of a topic with saving messages to it while it's offline.
+	topicLocks     map[string]*topicLock
+	topicLocksLock sync.Mutex
+}
+
+// Lock held while a topic is being loaded or while a message is saved to an offline topic.
+type topicLock struct {
+	sync.Mutex
+	// Number of goroutines holding or waiting for the lock.
+	refs int
 }
 
 func (h *Hub) topicGet(name string) *Topic {
@@ -121,6 +135,30 @@ func (h *Hub) topicDel(name string) {
 	h.topics.Delete(name)
 }
 
+// lockTopic acquires the lock of the named topic. Returns the function which releases it.
+func (h *Hub) lockTopic(name string) func() {
+	h.topicLocksLock.Lock()
+	l := h.topicLocks[name]
+	if l == nil {
+		l = &topicLock{}
+		h.topicLocks[name] = l
+	}
+	l.refs++
+	h.topicLocksLock.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+
+		h.topicLocksLock.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(h.topicLocks, name)
+		}
+		h.topicLocksLock.Unlock()
+	}
+}
+
 func newHub() *Hub {
 	var h = &Hub{
 		topics: &sync.Map{}, //make(map[string]*Topic),
@@ -131,6 +169,9 @@ func newHub() *Hub {
 		rehash:   make(chan bool),
 		meta:     make(chan *metaReq, 128),
 		shutdown: make(chan chan<- bool),
+		social:   newSocialHandler(),
+
+		topicLocks: make(map[string]*topicLock),
 	}
 
 	statsRegisterInt("LiveTopics")
@@ -179,6 +220,9 @@ func (h *Hub) run() {
 						log.Println("hub: topic's broadcast queue is full", dst.name)
 					}
 				}
+			} else if msg.sess == nil && msg.Data != nil {
+				// Server-generated message, such as a call record, for a topic which is not loaded.
+				go h.saveOfflineTopicMessage(msg)
 			} else if msg.Pres == nil && msg.Info == nil {
 				// Topic is unknown or offline.
 				// Pres & Info are silently ignored, all other messages are reported as invalid.
@@ -203,7 +247,8 @@ func (h *Hub) run() {
 					} else if meta.what == constMsgMetaSub {
 						go replyOfflineTopicGetSub(meta.sess, meta.topic, meta.pkt)
 					}
-				} else if meta.pkt.Set != nil {
+				} else if meta.pkt.Set != nil && meta.sess != nil {
+					// Server-generated updates of offline topics are already saved.
 					go replyOfflineTopicSetSub(meta.sess, meta.topic, meta.pkt)
 				}
 			}
@@ -235,6 +280,9 @@ func (h *Hub) run() {
 			// mark immediately to prevent more topics being added to hub.topics
 			h.isShutdownInProgress = true
 
+			// stop processing contact requests and signals
+			h.social.shutdown()
+
 			// start cleanup process
 			topicsdone := make(chan bool)
 			topicCount := 0
@@ -264,6 +312,10 @@ func (h *Hub) run() {
 func topicInit(sreg *sessionJoin, h *Hub) {
 	var t *Topic
 
+	// Messages cannot be saved to the topic while it's being loaded.
+	unlock := h.lockTopic(sreg.topic)
+	defer unlock()
+
 	timestamp := types.TimeNow()
 	pktsub := sreg.pkt.Sub
 
@@ -314,6 +366,12 @@ func topicInit(sreg *sessionJoin, h *Hub) {
 		t.accessAuth = user.Access.Auth
 		t.accessAnon = user.Access.Anon
 
+		if err = t.loadBlockList(sreg.sess.uid); err != nil {
+			log.Println("hub: cannot load block list for '" + t.name + "' (" + err.Error() + ")")
+			sreg.sess.queueOut(ErrUnknown(sreg.pkt.id, t.xoriginal, timestamp))
+			return
+		}
+
 		if err = t.loadSubscribers(); err != nil {
 			log.Println("hub: cannot load subscribers for '" + t.name + "' (" + err.Error() + ")")
 			sreg.sess.queueOut(ErrUnknown(sreg.pkt.id, t.xoriginal, timestamp))
@@ -643,6 +701,12 @@ func topicInit(sreg *sessionJoin, h *Hub) {
 			}
 		}
 
+		if err = t.loadPeerBlocks(); err != nil {
+			log.Println("hub: cannot load block list for '" + t.name + "' (" + err.Error() + ")")
+			sreg.sess.queueOut(ErrUnknown(sreg.pkt.id, t.xoriginal, timestamp))
+			return
+		}
+
 		// Clear original topic name.
 		t.xoriginal = ""
 
@@ -972,6 +1036,7 @@ func (h *Hub) topicUnreg(sess *Session, topic string, msg *ClientComMessage, rea
 
 				// Notify subscribers that the group topic is gone.
 				presSubsOfflineOffline(msg.topic, tcat, subs, "gone", &presParams{}, sess.sid)
+				globals.callRegistry.topicGone(topic)
 			}
 
 			sess.queueOut(NoErr(msg.id, msg.topic, now))
@@ -1245,3 +1310,35 @@ func replyOfflineTopicSetSub(sess *Session, topic string, msg *ClientComMessage)
 		sess.queueOut(InfoNotModified(msg.id, msg.topic, now))
 	}
 }
+
+// saveOfflineTopicMessage saves a server-generated message to a topic which is not loaded into memory.
+// If the topic has been loaded in the meantime, the message is passed to the topic.
+func (h *Hub) saveOfflineTopicMessage(msg *ServerComMessage) {
+	unlock := h.lockTopic(msg.rcptto)
+	defer unlock()
+
+	if dst := h.topicGet(msg.rcptto); dst != nil {
+		select {
+		case dst.broadcast <- msg:
+		default:
+			log.Println("hub: topic's broadcast queue is full", dst.name)
+		}
+		return
+	}
+
+	topic, err := store.Topics.Get(msg.rcptto)
+	if err != nil || topic == nil {
+		log.Println("hub: failed to save message to offline topic", msg.rcptto, err)
+		return
+	}
+
+	if err = store.Messages.Save(&types.Message{
+		ObjHeader: types.ObjHeader{CreatedAt: msg.Data.Timestamp},
+		SeqId:     topic.SeqId + 1,
+		Topic:     msg.rcptto,
+		From:      types.ParseUserId(msg.Data.From).String(),
+		Head:      msg.Data.Head,
+		Content:   msg.Data.Content}, false); err != nil {
+		log.Println("hub: failed to save message to offline topic", msg.rcptto, err)
+	}
+}
@@ -0,0 +1,169 @@
+/******************************************************************************
+ *
+ *  Description:
+ *
+ *  ICE (STUN/TURN) server configuration for audio and video calls. TURN credentials
+ *  are issued per the TURN REST API convention: the username is
+ *  "<expiration unix time>:<user ID>", the password is base64(HMAC-SHA1(secret, username)).
+ *
+ *****************************************************************************/
+
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/tinode/chat/server/store/types"
+)
+
+// Default lifetime of TURN credentials.
+const defaultIceCredentialTTL = 24 * time.Hour
+
+// iceConfig is the configuration of ICE servers handed to clients.
+type iceConfig struct {
+	// Disabled configuration is ignored.
+	Enabled bool `json:"enabled"`
+	// STUN server URLs, e.g. "stun:stun.example.com:3478". No credentials required.
+	Stun []string `json:"stun"`
+	// TURN server URLs, e.g. "turn:turn.example.com:3478?transport=udp".
+	Turn []string `json:"turn"`
+	// Secret shared with the TURN server (static-auth-secret in coturn).
+	Secret string `json:"secret"`
+	// Lifetime of TURN credentials in seconds.
+	TTL int `json:"ttl"`
+}
+
+// IceServers issues ICE server descriptions to users.
+type IceServers struct {
+	stun   []string
+	turn   []string
+	secret []byte
+	ttl    time.Duration
+}
+
+// newIceServers creates the issuer of ICE servers. Returns nil if ICE is not configured.
+func newIceServers(conf *iceConfig) *IceServers {
+	if conf == nil || !conf.Enabled {
+		return nil
+	}
+
+	if len(conf.Turn) > 0 && conf.Secret == "" {
+		log.Fatal("ICE: TURN servers are configured without the shared secret")
+	}
+
+	is := &IceServers{
+		stun:   conf.Stun,
+		turn:   conf.Turn,
+		secret: []byte(conf.Secret),
+		ttl:    defaultIceCredentialTTL,
+	}
+	if conf.TTL > 0 {
+		is.ttl = time.Duration(conf.TTL) * time.Second
+	}
+
+	return is
+}
+
+// get returns the list of ICE servers with TURN credentials valid for the given user.
+func (is *IceServers) get(uid types.Uid, now time.Time) []MsgIceServer {
+	var servers []MsgIceServer
+	if len(is.stun) > 0 {
+		servers = append(servers, MsgIceServer{Urls: is.stun})
+	}
+	if len(is.turn) > 0 {
+		expires := now.Add(is.ttl).Round(time.Second)
+		username := strconv.FormatInt(expires.Unix(), 10) + ":" + uid.UserId()
+
+		mac := hmac.New(sha1.New, is.secret)
+		mac.Write([]byte(username))
+
+		servers = append(servers, MsgIceServer{
+			Urls:       is.turn,
+			Username:   username,
+			Credential: base64.StdEncoding.EncodeToString(mac.Sum(nil)),
+			Expires:    &expires,
+		})
+	}
+	return servers
+}
+
+// replyGetIce is a response to a get.ice request on the 'me' topic.
+func (t *Topic) replyGetIce(sess *Session, asUid types.Uid, id string) error {
+	now := types.TimeNow()
+
+	if t.cat != types.TopicCatMe {
+		sess.queueOut(ErrPermissionDenied(id, t.original(asUid), now))
+		return types.ErrPermissionDenied
+	}
+
+	if globals.iceServers == nil {
+		sess.queueOut(ErrNotFound(id, t.original(asUid), now))
+		return nil
+	}
+
+	sess.queueOut(&ServerComMessage{
+		Meta: &MsgServerMeta{
+			Id:        id,
+			Topic:     t.original(asUid),
+			Timestamp: &now,
+			Ice:       globals.iceServers.get(asUid, now),
+		}})
+
+	return nil
+}
+
+// serveIceServers returns ICE servers to an authenticated HTTP client as a {meta} message.
+func serveIceServers(wrt http.ResponseWriter, req *http.Request) {
+	now := types.TimeNow()
+	enc := json.NewEncoder(wrt)
+
+	writeHttpResponse := func(msg *ServerComMessage) {
+		wrt.Header().Set("Content-Type", "application/json; charset=utf-8")
+		wrt.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+		if msg.Ctrl != nil {
+			wrt.WriteHeader(msg.Ctrl.Code)
+		}
+		enc.Encode(msg)
+	}
+
+	if req.Method != http.MethodGet {
+		writeHttpResponse(ErrOperationNotAllowed("", "", now))
+		return
+	}
+
+	// Check for API key presence
+	if isValid, _ := checkAPIKey(getAPIKey(req)); !isValid {
+		writeHttpResponse(ErrAPIKeyRequired(now))
+		return
+	}
+
+	// Check authorization: either auth information or SID must be present
+	uid, challenge, err := authHttpRequest(req)
+	if err != nil {
+		writeHttpResponse(decodeStoreError(err, "", "", now, nil))
+		return
+	}
+	if challenge != nil {
+		writeHttpResponse(InfoChallenge("", now, challenge))
+		return
+	}
+	if uid.IsZero() {
+		// Not authenticated
+		writeHttpResponse(ErrAuthRequired("", "", now))
+		return
+	}
+
+	writeHttpResponse(&ServerComMessage{
+		Meta: &MsgServerMeta{
+			Topic:     "me",
+			Timestamp: &now,
+			Ice:       globals.iceServers.get(uid, now),
+		}})
+}
@@ -40,8 +40,10 @@ import (
 
 	// Push notifications
 	"github.com/tinode/chat/server/push"
+	_ "github.com/tinode/chat/server/push/apns"
 	_ "github.com/tinode/chat/server/push/fcm"
 	_ "github.com/tinode/chat/server/push/stdout"
+	_ "github.com/tinode/chat/server/push/webhook"
 	_ "github.com/tinode/chat/server/push/xg"
 
 	"github.com/tinode/chat/server/store"
@@ -54,6 +56,9 @@ import (
 	// File upload handlers
 	_ "github.com/tinode/chat/server/media/fs"
 	_ "github.com/tinode/chat/server/media/s3"
+
+	// Embedded full-text search indexes
+	_ "github.com/tinode/chat/server/search/bleve"
 )
 
 const (
@@ -113,6 +118,11 @@ type credValidator struct {
 var globals struct {
 	hub          *Hub
 	sessionStore *SessionStore
+	callRegistry *CallRegistry
+	iceServers   *IceServers
+	pushTexts    *pushTextCatalog
+	contacts     *contactPolicy
+	messages     *messagePolicy
 	cluster      *Cluster
 	grpcServer   *grpc.Server
 	plugins      []Plugin
@@ -168,6 +178,14 @@ type mediaConfig struct {
 	Handlers map[string]json.RawMessage `json:"handlers"`
 }
 
+type searchConfig struct {
+	// The name of the embedded index to use for message search. If blank, messages are searched
+	// by the database adapter.
+	UseIndex string `json:"use_index"`
+	// Individual index config params to pass to indexes unchanged.
+	Indexes map[string]json.RawMessage `json:"indexes"`
+}
+
 // Contentx of the configuration file
 type configType struct {
 	// Default HTTP(S) address:port to listen on for websocket and long polling clients. Either a
@@ -208,6 +226,12 @@ type configType struct {
 	Auth      map[string]json.RawMessage  `json:"auth_config"`
 	Validator map[string]*validatorConfig `json:"acc_validation"`
 	Media     *mediaConfig                `json:"media"`
+	Calls     *callConfig                 `json:"calls"`
+	Ice       *iceConfig                  `json:"ice"`
+	PushTexts *pushTextsConfig            `json:"push_texts"`
+	Contacts  *contactsConfig             `json:"contacts"`
+	Messages  *messagesConfig             `json:"messages"`
+	Search    *searchConfig               `json:"search"`
 }
 
 func main() {
@@ -421,6 +445,16 @@ func main() {
 		}
 	}
 
+	if config.Search != nil && config.Search.UseIndex != "" {
+		var conf string
+		if params := config.Search.Indexes[config.Search.UseIndex]; params != nil {
+			conf = string(params)
+		}
+		if err = store.UseSearchIndex(config.Search.UseIndex, conf); err != nil {
+			log.Fatalf("Failed to init search index '%s': %s", config.Search.UseIndex, err)
+		}
+	}
+
 	err = push.Init(string(config.Push))
 	if err != nil {
 		log.Fatal("Failed to initialize push notifications:", err)
@@ -430,8 +464,21 @@ func main() {
 		log.Println("Stopped push notifications")
 	}()
 
+	globals.pushTexts, err = newPushTextCatalog(config.PushTexts)
+	if err != nil {
+		log.Fatal("Failed to load push notification texts:", err)
+	}
+
 	// Keep inactive LP sessions for 15 seconds
 	globals.sessionStore = NewSessionStore(idleSessionTimeout + 15*time.Second)
+	// Registry of active audio/video calls
+	globals.callRegistry = newCallRegistry(config.Calls)
+	if globals.cluster != nil {
+		log.Println("Audio and video calls are disabled in cluster mode")
+	}
+	globals.iceServers = newIceServers(config.Ice)
+	globals.contacts = newContactPolicy(config.Contacts)
+	globals.messages = newMessagePolicy(config.Messages)
 	// The hub (the main message router)
 	globals.hub = newHub()
 
@@ -510,6 +557,11 @@ func main() {
 		mux.Handle("/v0/file/s/", gh.CompressHandler(http.HandlerFunc(largeFileServe)))
 		log.Println("Large media handling enabled", config.Media.UseHandler)
 	}
+	if globals.iceServers != nil {
+		// Serve ICE servers and TURN credentials.
+		mux.HandleFunc("/v0/ice", serveIceServers)
+		log.Println("ICE servers enabled")
+	}
 
 	if staticMountPoint != "/" {
 		// Serve json-formatted 404 for all other URLs
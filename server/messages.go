@@ -0,0 +1,267 @@
+/******************************************************************************
+ *
+ *  Description:
+ *
+ *  Editing and recalling of published messages by their senders, replies to messages.
+ *
+ *****************************************************************************/
+
+package main
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/tinode/chat/server/push"
+	"github.com/tinode/chat/server/store"
+	"github.com/tinode/chat/server/store/types"
+)
+
+const (
+	// Default number of minutes after publishing during which the sender can edit the message.
+	defaultMessageEditWindowMinutes = 60
+	// Default maximum number of edits of one message.
+	defaultMaxMessageEdits = 16
+	// Default number of minutes after publishing during which the sender can recall the message.
+	defaultMessageRecallWindowMinutes = 2
+)
+
+// messagesConfig is the configuration of message editing and recalling.
+type messagesConfig struct {
+	// Number of minutes after publishing during which the sender can edit the message.
+	// Negative value: no limit.
+	EditWindowMinutes int `json:"edit_window"`
+	// Maximum number of edits of one message. Negative value: unlimited.
+	MaxEdits int `json:"max_edits"`
+	// Number of minutes after publishing during which the sender can recall the message.
+	// Negative value: no limit.
+	RecallWindowMinutes int `json:"recall_window"`
+}
+
+// messagePolicy holds the limits of message editing and recalling.
+type messagePolicy struct {
+	// Zero means no limit.
+	editWindow time.Duration
+	// Zero means unlimited.
+	maxEdits int
+	// Zero means no limit.
+	recallWindow time.Duration
+}
+
+func newMessagePolicy(conf *messagesConfig) *messagePolicy {
+	mp := &messagePolicy{
+		editWindow:   defaultMessageEditWindowMinutes * time.Minute,
+		maxEdits:     defaultMaxMessageEdits,
+		recallWindow: defaultMessageRecallWindowMinutes * time.Minute,
+	}
+	if conf != nil {
+		if conf.EditWindowMinutes > 0 {
+			mp.editWindow = time.Duration(conf.EditWindowMinutes) * time.Minute
+		} else if conf.EditWindowMinutes < 0 {
+			mp.editWindow = 0
+		}
+		if conf.MaxEdits > 0 {
+			mp.maxEdits = conf.MaxEdits
+		} else if conf.MaxEdits < 0 {
+			mp.maxEdits = 0
+		}
+		if conf.RecallWindowMinutes > 0 {
+			mp.recallWindow = time.Duration(conf.RecallWindowMinutes) * time.Minute
+		} else if conf.RecallWindowMinutes < 0 {
+			mp.recallWindow = 0
+		}
+	}
+	return mp
+}
+
+// canEdit checks if a message published at the given time can still be edited.
+func (mp *messagePolicy) canEdit(published, now time.Time) bool {
+	return mp.editWindow == 0 || now.Before(published.Add(mp.editWindow))
+}
+
+// canRecall checks if a message published at the given time can still be recalled.
+func (mp *messagePolicy) canRecall(published, now time.Time) bool {
+	return mp.recallWindow == 0 || now.Before(published.Add(mp.recallWindow))
+}
+
+// replyEditMessage replaces content of the sender's earlier message, keeping the previous content as
+// a revision. On success msg.Data is updated to describe the edited message so it can be broadcast to
+// subscribers and true is returned. Otherwise the error is sent to the session and false is returned.
+func (t *Topic) replyEditMessage(msg *ServerComMessage, asUid types.Uid) bool {
+	toriginal := t.original(asUid)
+
+	if t.isSuspended() {
+		msg.sess.queueOut(ErrLocked(msg.id, toriginal, msg.timestamp))
+		return false
+	}
+
+	from := types.ParseUserId(msg.Data.From)
+	if userData := t.perUser[from]; !(userData.modeWant & userData.modeGiven).IsWriter() {
+		msg.sess.queueOut(ErrPermissionDenied(msg.id, toriginal, msg.timestamp))
+		return false
+	}
+
+	if t.cat == types.TopicCatP2P && t.isBlockedByPeer(from) {
+		// The peer has blocked the sender: pretend the edit was accepted.
+		if msg.id != "" {
+			msg.sess.queueOut(NoErrAccepted(msg.id, toriginal, msg.timestamp))
+		}
+		return false
+	}
+
+	seq := msg.Data.replace
+	if seq > t.lastID {
+		msg.sess.queueOut(ErrNotFound(msg.id, toriginal, msg.timestamp))
+		return false
+	}
+
+	mm, err := store.Messages.Get(t.name, seq)
+	if err != nil {
+		log.Printf("topic[%s]: failed to load message for editing: %v", t.name, err)
+		msg.sess.queueOut(ErrUnknown(msg.id, toriginal, msg.timestamp))
+		return false
+	}
+	if mm == nil || mm.DelId > 0 {
+		msg.sess.queueOut(ErrNotFound(msg.id, toriginal, msg.timestamp))
+		return false
+	}
+	if mm.From != from.String() {
+		// Only the sender can edit the message.
+		msg.sess.queueOut(ErrPermissionDenied(msg.id, toriginal, msg.timestamp))
+		return false
+	}
+
+	policy := globals.messages
+	if !policy.canEdit(mm.CreatedAt, msg.timestamp) ||
+		(policy.maxEdits > 0 && len(mm.Revisions) >= policy.maxEdits) {
+		msg.sess.queueOut(ErrPolicy(msg.id, toriginal, msg.timestamp))
+		return false
+	}
+
+	if err := store.Messages.Edit(mm, msg.Data.Content); err != nil {
+		msg.sess.queueOut(decodeStoreError(err, msg.id, toriginal, msg.timestamp, nil))
+		return false
+	}
+
+	if msg.id != "" {
+		reply := NoErrAccepted(msg.id, toriginal, msg.timestamp)
+		reply.Ctrl.Params = map[string]int{"seq": seq}
+		msg.sess.queueOut(reply)
+	}
+
+	// Subscribers receive the message with the new content and the time of the edit.
+	// Revisions are available through {get what="data"}.
+	msg.Data.SeqId = seq
+	msg.Data.Timestamp = mm.CreatedAt
+	msg.Data.Head = mm.Head
+	msg.Data.EditedAt = mm.EditedAt
+	msg.Data.ReplyTo = mm.ReplyTo
+
+	return true
+}
+
+// replyRecallMsg hard-deletes the sender's own message for all subscribers in response to
+// {del what="recall"}. The sender can recall the message within the recall window regardless of the
+// access mode. The message is kept as a tombstone marked as recalled.
+func (t *Topic) replyRecallMsg(sess *Session, asUid types.Uid, del *MsgClientDel) error {
+	now := types.TimeNow()
+	toriginal := t.original(asUid)
+
+	if t.isSuspended() {
+		sess.queueOut(ErrLocked(del.Id, toriginal, now))
+		return errors.New("del.recall: topic is suspended")
+	}
+
+	if len(del.DelSeq) != 1 {
+		sess.queueOut(ErrMalformed(del.Id, toriginal, now))
+		return errors.New("del.recall: exactly one message must be recalled")
+	}
+	dq := del.DelSeq[0]
+	if dq.LowId <= 0 || dq.LowId > t.lastID || (dq.HiId != 0 && dq.HiId != dq.LowId+1) {
+		sess.queueOut(ErrMalformed(del.Id, toriginal, now))
+		return errors.New("del.recall: invalid message ID")
+	}
+	seq := dq.LowId
+
+	mm, err := store.Messages.Get(t.name, seq)
+	if err != nil {
+		sess.queueOut(ErrUnknown(del.Id, toriginal, now))
+		return err
+	}
+	if mm == nil || mm.DelId > 0 {
+		sess.queueOut(ErrNotFound(del.Id, toriginal, now))
+		return nil
+	}
+	if mm.From != asUid.String() {
+		// Only the sender can recall the message.
+		sess.queueOut(ErrPermissionDenied(del.Id, toriginal, now))
+		return errors.New("del.recall: not the sender")
+	}
+	if !globals.messages.canRecall(mm.CreatedAt, now) {
+		sess.queueOut(ErrPolicy(del.Id, toriginal, now))
+		return nil
+	}
+
+	if err = store.Messages.Recall(t.name, t.delID+1, seq); err != nil {
+		sess.queueOut(ErrUnknown(del.Id, toriginal, now))
+		return err
+	}
+
+	t.delID++
+	for uid, pud := range t.perUser {
+		pud.delID = t.delID
+		t.perUser[uid] = pud
+	}
+
+	// Broadcast the change to all, online and offline, exclude the session making the change.
+	params := &presParams{delID: t.delID, delSeq: []MsgDelRange{{LowId: seq}}, actor: asUid.UserId()}
+	filters := &presFilters{filterIn: types.ModeRead}
+	t.presSubsOnline("del", params.actor, params, filters, sess.sid)
+	t.presSubsOffline("del", params, filters, sess.sid, true)
+
+	// Tell devices to remove notifications of the recalled message.
+	if rcpt := t.makeRecallReceipt(asUid, seq, now); rcpt != nil {
+		push.Push(rcpt.rcpt)
+	}
+
+	reply := NoErr(del.Id, toriginal, now)
+	reply.Ctrl.Params = map[string]int{"del": t.delID}
+	sess.queueOut(reply)
+
+	return nil
+}
+
+// checkReplyParent checks that the message the {pub} replies to exists and is not deleted. Otherwise
+// the error is sent to the session and false is returned.
+func (t *Topic) checkReplyParent(msg *ServerComMessage, asUid types.Uid) bool {
+	parent := msg.Data.ReplyTo
+	if parent < 0 || parent > t.lastID {
+		msg.sess.queueOut(ErrMalformed(msg.id, t.original(asUid), msg.timestamp))
+		return false
+	}
+
+	mm, err := store.Messages.Get(t.name, parent)
+	if err != nil {
+		log.Printf("topic[%s]: failed to load parent message: %v", t.name, err)
+		msg.sess.queueOut(ErrUnknown(msg.id, t.original(asUid), msg.timestamp))
+		return false
+	}
+	if mm == nil || mm.DelId > 0 {
+		msg.sess.queueOut(ErrNotFound(msg.id, t.original(asUid), msg.timestamp))
+		return false
+	}
+	return true
+}
+
+// messageRevisions converts revisions of a stored message to wire format.
+func messageRevisions(revs types.MessageRevisions) []MsgRevision {
+	if len(revs) == 0 {
+		return nil
+	}
+	out := make([]MsgRevision, len(revs))
+	for i := range revs {
+		out[i] = MsgRevision{Timestamp: revs[i].Timestamp, Content: revs[i].Content}
+	}
+	return out
+}
@@ -35,7 +35,110 @@ func pbServDataSerialize(data *MsgServerData) *pbx.ServerMsg_Data {
 		DeletedAt:  timeToInt64(data.DeletedAt),
 		SeqId:      int32(data.SeqId),
 		Head:       interfaceMapToByteMap(data.Head),
-		Content:    interfaceToBytes(data.Content)}}
+		Content:    interfaceToBytes(data.Content),
+		EditedAt:   timeToInt64(data.EditedAt),
+		Revisions:  pbRevisionsSerialize(data.Revisions),
+		Recalled:   data.Recalled,
+		Reactions:  pbReactionsSerialize(data.Reactions),
+		ReplyTo:    int32(data.ReplyTo),
+		Replies:    int32(data.Replies)}}
+}
+
+func pbRevisionsSerialize(revs []MsgRevision) []*pbx.MessageRevision {
+	if len(revs) == 0 {
+		return nil
+	}
+	out := make([]*pbx.MessageRevision, len(revs))
+	for i := range revs {
+		out[i] = &pbx.MessageRevision{
+			Timestamp: timeToInt64(&revs[i].Timestamp),
+			Content:   interfaceToBytes(revs[i].Content)}
+	}
+	return out
+}
+
+func pbRevisionsDeserialize(revs []*pbx.MessageRevision) []MsgRevision {
+	if len(revs) == 0 {
+		return nil
+	}
+	out := make([]MsgRevision, len(revs))
+	for i, rev := range revs {
+		if ts := int64ToTime(rev.GetTimestamp()); ts != nil {
+			out[i].Timestamp = *ts
+		}
+		out[i].Content = rev.GetContent()
+	}
+	return out
+}
+
+func pbReactionsSerialize(reactions []MsgReaction) []*pbx.MessageReaction {
+	if len(reactions) == 0 {
+		return nil
+	}
+	out := make([]*pbx.MessageReaction, len(reactions))
+	for i := range reactions {
+		out[i] = &pbx.MessageReaction{
+			Emoji: reactions[i].Emoji,
+			Count: int32(reactions[i].Count),
+			Mine:  reactions[i].Mine}
+	}
+	return out
+}
+
+func pbReactionsDeserialize(reactions []*pbx.MessageReaction) []MsgReaction {
+	if len(reactions) == 0 {
+		return nil
+	}
+	out := make([]MsgReaction, len(reactions))
+	for i, r := range reactions {
+		out[i] = MsgReaction{
+			Emoji: r.GetEmoji(),
+			Count: int(r.GetCount()),
+			Mine:  r.GetMine()}
+	}
+	return out
+}
+
+func pbSearchHitsSerialize(hits []MsgSearchHit) []*pbx.SearchHit {
+	if len(hits) == 0 {
+		return nil
+	}
+	out := make([]*pbx.SearchHit, len(hits))
+	for i := range hits {
+		hl := make([]*pbx.Highlight, len(hits[i].Highlights))
+		for j, h := range hits[i].Highlights {
+			hl[j] = &pbx.Highlight{At: int32(h.At), Len: int32(h.Len)}
+		}
+		out[i] = &pbx.SearchHit{
+			Topic:      hits[i].Topic,
+			SeqId:      int32(hits[i].SeqId),
+			FromUserId: hits[i].From,
+			Timestamp:  timeToInt64(&hits[i].Timestamp),
+			Text:       hits[i].Text,
+			Highlights: hl}
+	}
+	return out
+}
+
+func pbSearchHitsDeserialize(hits []*pbx.SearchHit) []MsgSearchHit {
+	if len(hits) == 0 {
+		return nil
+	}
+	out := make([]MsgSearchHit, len(hits))
+	for i, hit := range hits {
+		out[i] = MsgSearchHit{
+			Topic: hit.GetTopic(),
+			SeqId: int(hit.GetSeqId()),
+			From:  hit.GetFromUserId(),
+			Text:  hit.GetText()}
+		if ts := int64ToTime(hit.GetTimestamp()); ts != nil {
+			out[i].Timestamp = *ts
+		}
+		for _, h := range hit.GetHighlights() {
+			out[i].Highlights = append(out[i].Highlights, MsgHighlight{At: int(h.GetAt()), Len: int(h.GetLen())})
+		}
+	}
+	return out
 }
 
 func pbServPresSerialize(pres *MsgServerPres) *pbx.ServerMsg_Pres {
@@ -63,6 +166,20 @@ func pbServPresSerialize(pres *MsgServerPres) *pbx.ServerMsg_Pres {
 		what = pbx.ServerPres_RECV
 	case "del":
 		what = pbx.ServerPres_DEL
+	case "ctadd":
+		what = pbx.ServerPres_CTADD
+	case "ctreject":
+		what = pbx.ServerPres_CTREJECT
+	case "ctagree":
+		what = pbx.ServerPres_CTAGREE
+	case "ctmdel":
+		what = pbx.ServerPres_CTMDEL
+	case "signal":
+		what = pbx.ServerPres_SIGNAL
+	case "ctdel":
+		what = pbx.ServerPres_CTDEL
+	case "ctupd":
+		what = pbx.ServerPres_CTUPD
 	default:
 		log.Fatal("Unknown pres.what value", pres.What)
 	}
@@ -76,26 +193,63 @@ func pbServPresSerialize(pres *MsgServerPres) *pbx.ServerMsg_Pres {
 		DelSeq:       pbDelQuerySerialize(pres.DelSeq),
 		TargetUserId: pres.AcsTarget,
 		ActorUserId:  pres.AcsActor,
-		Acs:          pbAccessModeSerialize(pres.Acs)}}
+		Acs:          pbAccessModeSerialize(pres.Acs),
+		ContactId:    pres.ContactId,
+		SgAction:     pres.SgAction,
+		Room:         pres.Room,
+		UserId:       pres.User,
+		Public:       interfaceToBytes(pres.Public)}}
 }
 
 func pbServInfoSerialize(info *MsgServerInfo) *pbx.ServerMsg_Info {
 	return &pbx.ServerMsg_Info{Info: &pbx.ServerInfo{
-		Topic:      info.Topic,
-		FromUserId: info.From,
-		What:       pbInfoNoteWhatSerialize(info.What),
-		SeqId:      int32(info.SeqId),
+		Topic:        info.Topic,
+		FromUserId:   info.From,
+		What:         pbInfoNoteWhatSerialize(info.What),
+		SeqId:        int32(info.SeqId),
+		ContactId:    info.ContactId,
+		ContactState: int32(info.ContactState),
+		Emoji:        info.Emoji,
 	}}
 }
 
 func pbServMetaSerialize(meta *MsgServerMeta) *pbx.ServerMsg_Meta {
 	return &pbx.ServerMsg_Meta{Meta: &pbx.ServerMeta{
-		Id:    meta.Id,
-		Topic: meta.Topic,
-		Desc:  pbTopicDescSerialize(meta.Desc),
-		Sub:   pbTopicSubSliceSerialize(meta.Sub),
-		Del:   pbDelValuesSerialize(meta.Del),
-		Tags:  meta.Tags,
+		Id:      meta.Id,
+		Topic:   meta.Topic,
+		Desc:    pbTopicDescSerialize(meta.Desc),
+		Sub:     pbTopicSubSliceSerialize(meta.Sub),
+		Del:     pbDelValuesSerialize(meta.Del),
+		Tags:    meta.Tags,
+		Ctmsg:   pbContactMsgSliceSerialize(meta.ContactMsg),
+		Contact: pbContactSliceSerialize(meta.Contact),
+		Ice:     pbIceServerSliceSerialize(meta.Ice),
+		Call:    pbCallInfoSerialize(meta.Call),
+		Blocked: meta.Blocked,
+		Privacy: pbPrivacySerialize(meta.Privacy),
+		Search:  pbSearchHitsSerialize(meta.Search),
+	}}
+}
+
+func pbServContactSerialize(contact *MsgServerContact) *pbx.ServerMsg_Contact {
+	return &pbx.ServerMsg_Contact{Contact: &pbx.ServerContact{
+		What:      contact.What,
+		Sender:    contact.Sender,
+		Receiver:  contact.Receiver,
+		ContactId: contact.ContactId,
+		Message:   contact.Message,
+		Source:    contact.Source,
+	}}
+}
+
+func pbServSignalSerialize(signal *MsgServerSignal) *pbx.ServerMsg_Signal {
+	return &pbx.ServerMsg_Signal{Signal: &pbx.ServerSignal{
+		Target:  signal.Target,
+		Command: signal.Command,
+		Room:    signal.Room,
+		From:    signal.From,
+		User:    signal.User,
+		Payload: signal.Payload,
 	}}
 }
 
@@ -114,6 +268,10 @@ func pbServSerialize(msg *ServerComMessage) *pbx.ServerMsg {
 		pkt.Message = pbServInfoSerialize(msg.Info)
 	case msg.Meta != nil:
 		pkt.Message = pbServMetaSerialize(msg.Meta)
+	case msg.Contact != nil:
+		pkt.Message = pbServContactSerialize(msg.Contact)
+	case msg.Signal != nil:
+		pkt.Message = pbServSignalSerialize(msg.Signal)
 	}
 
 	pkt.Topic = msg.rcptto
@@ -144,6 +302,12 @@ func pbServDeserialize(pkt *pbx.ServerMsg) *ServerComMessage {
 			SeqId:     int(data.GetSeqId()),
 			Head:      byteMapToInterfaceMap(data.GetHead()),
 			Content:   data.GetContent(),
+			EditedAt:  int64ToTime(data.GetEditedAt()),
+			Revisions: pbRevisionsDeserialize(data.GetRevisions()),
+			Recalled:  data.GetRecalled(),
+			Reactions: pbReactionsDeserialize(data.GetReactions()),
+			ReplyTo:   int(data.GetReplyTo()),
+			Replies:   int(data.GetReplies()),
 		}
 	} else if pres := pkt.GetPres(); pres != nil {
 		var what string
@@ -170,6 +334,20 @@ func pbServDeserialize(pkt *pbx.ServerMsg) *ServerComMessage {
 			what = "recv"
 		case pbx.ServerPres_DEL:
 			what = "del"
+		case pbx.ServerPres_CTADD:
+			what = "ctadd"
+		case pbx.ServerPres_CTREJECT:
+			what = "ctreject"
+		case pbx.ServerPres_CTAGREE:
+			what = "ctagree"
+		case pbx.ServerPres_CTMDEL:
+			what = "ctmdel"
+		case pbx.ServerPres_SIGNAL:
+			what = "signal"
+		case pbx.ServerPres_CTDEL:
+			what = "ctdel"
+		case pbx.ServerPres_CTUPD:
+			what = "ctupd"
 		}
 		msg.Pres = &MsgServerPres{
 			Topic:     pres.GetTopic(),
@@ -182,22 +360,55 @@ func pbServDeserialize(pkt *pbx.ServerMsg) *ServerComMessage {
 			AcsTarget: pres.GetTargetUserId(),
 			AcsActor:  pres.GetActorUserId(),
 			Acs:       pbAccessModeDeserialize(pres.GetAcs()),
+			ContactId: pres.GetContactId(),
+			SgAction:  pres.GetSgAction(),
+			Room:      pres.GetRoom(),
+			User:      pres.GetUserId(),
+			Public:    bytesToInterface(pres.GetPublic()),
 		}
 	} else if info := pkt.GetInfo(); info != nil {
 		msg.Info = &MsgServerInfo{
-			Topic: info.GetTopic(),
-			From:  info.GetFromUserId(),
-			What:  pbInfoNoteWhatDeserialize(info.GetWhat()),
-			SeqId: int(info.GetSeqId()),
+			Topic:        info.GetTopic(),
+			From:         info.GetFromUserId(),
+			What:         pbInfoNoteWhatDeserialize(info.GetWhat()),
+			SeqId:        int(info.GetSeqId()),
+			ContactId:    info.GetContactId(),
+			ContactState: int(info.GetContactState()),
+			Emoji:        info.GetEmoji(),
 		}
 	} else if meta := pkt.GetMeta(); meta != nil {
 		msg.Meta = &MsgServerMeta{
-			Id:    meta.GetId(),
-			Topic: meta.GetTopic(),
-			Desc:  pbTopicDescDeserialize(meta.GetDesc()),
-			Sub:   pbTopicSubSliceDeserialize(meta.GetSub()),
-			Del:   pbDelValuesDeserialize(meta.GetDel()),
-			Tags:  meta.GetTags(),
+			Id:         meta.GetId(),
+			Topic:      meta.GetTopic(),
+			Desc:       pbTopicDescDeserialize(meta.GetDesc()),
+			Sub:        pbTopicSubSliceDeserialize(meta.GetSub()),
+			Del:        pbDelValuesDeserialize(meta.GetDel()),
+			Tags:       meta.GetTags(),
+			ContactMsg: pbContactMsgSliceDeserialize(meta.GetCtmsg()),
+			Contact:    pbContactSliceDeserialize(meta.GetContact()),
+			Ice:        pbIceServerSliceDeserialize(meta.GetIce()),
+			Call:       pbCallInfoDeserialize(meta.GetCall()),
+			Blocked:    meta.GetBlocked(),
+			Privacy:    pbPrivacyDeserialize(meta.GetPrivacy()),
+			Search:     pbSearchHitsDeserialize(meta.GetSearch()),
+		}
+	} else if contact := pkt.GetContact(); contact != nil {
+		msg.Contact = &MsgServerContact{
+			What:      contact.GetWhat(),
+			Sender:    contact.GetSender(),
+			Receiver:  contact.GetReceiver(),
+			ContactId: contact.GetContactId(),
+			Message:   contact.GetMessage(),
+			Source:    contact.GetSource(),
+		}
+	} else if signal := pkt.GetSignal(); signal != nil {
+		msg.Signal = &MsgServerSignal{
+			Target:  signal.GetTarget(),
+			Command: signal.GetCommand(),
+			Room:    signal.GetRoom(),
+			From:    signal.GetFrom(),
+			User:    signal.GetUser(),
+			Payload: signal.GetPayload(),
 		}
 	}
 	return &msg
@@ -250,7 +461,9 @@ func pbCliSerialize(msg *ClientComMessage) *pbx.ClientMsg {
 			Topic:   msg.Pub.Topic,
 			NoEcho:  msg.Pub.NoEcho,
 			Head:    interfaceMapToByteMap(msg.Pub.Head),
-			Content: interfaceToBytes(msg.Pub.Content)}}
+			Content: interfaceToBytes(msg.Pub.Content),
+			Replace: int32(msg.Pub.Replace),
+			ReplyTo: int32(msg.Pub.ReplyTo)}}
 	case msg.Get != nil:
 		pkt.Message = &pbx.ClientMsg_Get{Get: &pbx.ClientGet{
 			Id:    msg.Get.Id,
@@ -272,19 +485,52 @@ func pbCliSerialize(msg *ClientComMessage) *pbx.ClientMsg {
 			what = pbx.ClientDel_SUB
 		case "user":
 			what = pbx.ClientDel_USER
+		case "ctmsg":
+			what = pbx.ClientDel_CTMSG
+		case "contact":
+			what = pbx.ClientDel_CONTACT
+		case "recall":
+			what = pbx.ClientDel_RECALL
 		}
 		pkt.Message = &pbx.ClientMsg_Del{Del: &pbx.ClientDel{
-			Id:     msg.Del.Id,
-			Topic:  msg.Del.Topic,
-			What:   what,
-			DelSeq: pbDelQuerySerialize(msg.Del.DelSeq),
-			UserId: msg.Del.User,
-			Hard:   msg.Del.Hard}}
+			Id:           msg.Del.Id,
+			Topic:        msg.Del.Topic,
+			What:         what,
+			DelSeq:       pbDelQuerySerialize(msg.Del.DelSeq),
+			UserId:       msg.Del.User,
+			Hard:         msg.Del.Hard,
+			DelCtMsgId:   msg.Del.DelCtMsgId,
+			DelCtUser:    msg.Del.DelCtMsgUser,
+			DelCtContact: msg.Del.DelCtMsgContact,
+			DelCtId:      msg.Del.DelCtId}}
 	case msg.Note != nil:
 		pkt.Message = &pbx.ClientMsg_Note{Note: &pbx.ClientNote{
-			Topic: msg.Note.Topic,
-			What:  pbInfoNoteWhatSerialize(msg.Note.What),
-			SeqId: int32(msg.Note.SeqId)}}
+			Topic:        msg.Note.Topic,
+			What:         pbInfoNoteWhatSerialize(msg.Note.What),
+			SeqId:        int32(msg.Note.SeqId),
+			ContactId:    msg.Note.ContactId,
+			ContactState: int32(msg.Note.ContactState),
+			Emoji:        msg.Note.Emoji}}
+	case msg.Contact != nil:
+		pkt.Message = &pbx.ClientMsg_Contact{Contact: &pbx.ClientContact{
+			Id:        msg.Contact.Id,
+			Topic:     msg.Contact.Topic,
+			Sender:    msg.Contact.Sender,
+			Receiver:  msg.Contact.Receiver,
+			ContactId: msg.Contact.ContactId,
+			What:      msg.Contact.What,
+			Message:   msg.Contact.Message,
+			Source:    msg.Contact.Source,
+			Answer:    msg.Contact.Answer}}
+	case msg.Signal != nil:
+		pkt.Message = &pbx.ClientMsg_Signal{Signal: &pbx.ClientSignal{
+			Id:      msg.Signal.Id,
+			Topic:   msg.Signal.Topic,
+			Target:  msg.Signal.Target,
+			Command: msg.Signal.Command,
+			Room:    msg.Signal.Room,
+			User:    msg.Signal.User,
+			Payload: msg.Signal.Payload}}
 	}
 
 	if pkt.Message == nil {
@@ -347,6 +593,8 @@ func pbCliDeserialize(pkt *pbx.ClientMsg) *ClientComMessage {
 			NoEcho:  pub.GetNoEcho(),
 			Head:    byteMapToInterfaceMap(pub.GetHead()),
 			Content: bytesToInterface(pub.GetContent()),
+			Replace: int(pub.GetReplace()),
+			ReplyTo: int(pub.GetReplyTo()),
 		}
 	} else if get := pkt.GetGet(); get != nil {
 		msg.Get = &MsgClientGet{
@@ -362,11 +610,15 @@ func pbCliDeserialize(pkt *pbx.ClientMsg) *ClientComMessage {
 		}
 	} else if del := pkt.GetDel(); del != nil {
 		msg.Del = &MsgClientDel{
-			Id:     del.GetId(),
-			Topic:  del.GetTopic(),
-			DelSeq: pbDelQueryDeserialize(del.GetDelSeq()),
-			User:   del.GetUserId(),
-			Hard:   del.GetHard(),
+			Id:              del.GetId(),
+			Topic:           del.GetTopic(),
+			DelSeq:          pbDelQueryDeserialize(del.GetDelSeq()),
+			User:            del.GetUserId(),
+			Hard:            del.GetHard(),
+			DelCtMsgId:      del.GetDelCtMsgId(),
+			DelCtMsgUser:    del.GetDelCtUser(),
+			DelCtMsgContact: del.GetDelCtContact(),
+			DelCtId:         del.GetDelCtId(),
 		}
 		switch del.GetWhat() {
 		case pbx.ClientDel_MSG:
@@ -377,11 +629,20 @@ func pbCliDeserialize(pkt *pbx.ClientMsg) *ClientComMessage {
 			msg.Del.What = "sub"
 		case pbx.ClientDel_USER:
 			msg.Del.What = "user"
+		case pbx.ClientDel_CTMSG:
+			msg.Del.What = "ctmsg"
+		case pbx.ClientDel_CONTACT:
+			msg.Del.What = "contact"
+		case pbx.ClientDel_RECALL:
+			msg.Del.What = "recall"
 		}
 	} else if note := pkt.GetNote(); note != nil {
 		msg.Note = &MsgClientNote{
-			Topic: note.GetTopic(),
-			SeqId: int(note.GetSeqId()),
+			Topic:        note.GetTopic(),
+			SeqId:        int(note.GetSeqId()),
+			ContactId:    note.GetContactId(),
+			ContactState: int(note.GetContactState()),
+			Emoji:        note.GetEmoji(),
 		}
 		switch note.GetWhat() {
 		case pbx.InfoNote_READ:
@@ -390,6 +651,34 @@ func pbCliDeserialize(pkt *pbx.ClientMsg) *ClientComMessage {
 			msg.Note.What = "recv"
 		case pbx.InfoNote_KP:
 			msg.Note.What = "kp"
+		case pbx.InfoNote_CTREAD:
+			msg.Note.What = "ctread"
+		case pbx.InfoNote_REACT:
+			msg.Note.What = "react"
+		case pbx.InfoNote_UNREACT:
+			msg.Note.What = "unreact"
+		}
+	} else if contact := pkt.GetContact(); contact != nil {
+		msg.Contact = &MsgClientContactMessage{
+			Id:        contact.GetId(),
+			Topic:     contact.GetTopic(),
+			Sender:    contact.GetSender(),
+			Receiver:  contact.GetReceiver(),
+			ContactId: contact.GetContactId(),
+			What:      contact.GetWhat(),
+			Message:   contact.GetMessage(),
+			Source:    contact.GetSource(),
+			Answer:    contact.GetAnswer(),
+		}
+	} else if signal := pkt.GetSignal(); signal != nil {
+		msg.Signal = &MsgClientSignal{
+			Id:      signal.GetId(),
+			Topic:   signal.GetTopic(),
+			Target:  signal.GetTarget(),
+			Command: signal.GetCommand(),
+			Room:    signal.GetRoom(),
+			User:    signal.GetUser(),
+			Payload: signal.GetPayload(),
 		}
 	}
 
@@ -480,7 +769,31 @@ func pbGetQuerySerialize(in *MsgGetQuery) *pbx.GetQuery {
 		out.Data = &pbx.GetOpts{
 			BeforeId: int32(in.Data.BeforeId),
 			SinceId:  int32(in.Data.SinceId),
-			Limit:    int32(in.Data.Limit)}
+			Limit:    int32(in.Data.Limit),
+			Thread:   int32(in.Data.Thread)}
+	}
+	if in.ContactMsg != nil {
+		out.Ctmsg = &pbx.GetOpts{
+			IfModifiedSince: timeToInt64(in.ContactMsg.IfModifiedSince),
+			BeforeId:        int32(in.ContactMsg.BeforeId),
+			SinceId:         int32(in.ContactMsg.SinceId),
+			Limit:           int32(in.ContactMsg.Limit)}
+	}
+	if in.Contact != nil {
+		out.Contact = &pbx.GetOpts{
+			IfModifiedSince: timeToInt64(in.Contact.IfModifiedSince),
+			BeforeId:        int32(in.Contact.BeforeId),
+			SinceId:         int32(in.Contact.SinceId),
+			Limit:           int32(in.Contact.Limit),
+			Label:           in.Contact.Label}
+	}
+	if in.Search != nil {
+		out.Search = &pbx.GetOpts{
+			BeforeId: int32(in.Search.BeforeId),
+			SinceId:  int32(in.Search.SinceId),
+			Limit:    int32(in.Search.Limit),
+			Query:    in.Search.Query,
+			Read:     in.Search.Read}
 	}
 	return out
 }
@@ -508,6 +821,33 @@ func pbGetQueryDeserialize(in *pbx.GetQuery) *MsgGetQuery {
 				BeforeId: int(data.GetBeforeId()),
 				SinceId:  int(data.GetSinceId()),
 				Limit:    int(data.GetLimit()),
+				Thread:   int(data.GetThread()),
+			}
+		}
+		if ctmsg := in.GetCtmsg(); ctmsg != nil {
+			msg.ContactMsg = &MsgGetOpts{
+				IfModifiedSince: int64ToTime(ctmsg.GetIfModifiedSince()),
+				BeforeId:        int(ctmsg.GetBeforeId()),
+				SinceId:         int(ctmsg.GetSinceId()),
+				Limit:           int(ctmsg.GetLimit()),
+			}
+		}
+		if contact := in.GetContact(); contact != nil {
+			msg.Contact = &MsgGetOpts{
+				IfModifiedSince: int64ToTime(contact.GetIfModifiedSince()),
+				BeforeId:        int(contact.GetBeforeId()),
+				SinceId:         int(contact.GetSinceId()),
+				Limit:           int(contact.GetLimit()),
+				Label:           contact.GetLabel(),
+			}
+		}
+		if search := in.GetSearch(); search != nil {
+			msg.Search = &MsgGetOpts{
+				BeforeId: int(search.GetBeforeId()),
+				SinceId:  int(search.GetSinceId()),
+				Limit:    int(search.GetLimit()),
+				Query:    search.GetQuery(),
+				Read:     search.GetRead(),
 			}
 		}
 	}
@@ -569,6 +909,30 @@ func pbSetQuerySerialize(in *MsgSetQuery) *pbx.SetQuery {
 
 	out.Tags = in.Tags
 
+	if in.Block != nil {
+		out.Block = &pbx.SetBlock{
+			Add: in.Block.Add,
+			Rem: in.Block.Remove,
+		}
+	}
+
+	out.Privacy = pbPrivacySerialize(in.Privacy)
+
+	if in.Contact != nil {
+		out.Contact = &pbx.SetContact{
+			UserId: in.Contact.User,
+			Remark: in.Contact.Remark,
+			Labels: in.Contact.Labels,
+		}
+		if in.Contact.Starred != nil {
+			if *in.Contact.Starred {
+				out.Contact.Starred = pbx.SetContact_STAR
+			} else {
+				out.Contact.Starred = pbx.SetContact_UNSTAR
+			}
+		}
+	}
+
 	return out
 }
 
@@ -604,11 +968,69 @@ func pbSetQueryDeserialize(in *pbx.SetQuery) *MsgSetQuery {
 			}
 			msg.Tags = in.GetTags()
 		}
+
+		if block := in.GetBlock(); block != nil {
+			if msg == nil {
+				msg = &MsgSetQuery{}
+			}
+			msg.Block = &MsgSetBlock{
+				Add:    block.GetAdd(),
+				Remove: block.GetRem(),
+			}
+		}
+
+		if privacy := in.GetPrivacy(); privacy != nil {
+			if msg == nil {
+				msg = &MsgSetQuery{}
+			}
+			msg.Privacy = pbPrivacyDeserialize(privacy)
+		}
+
+		if contact := in.GetContact(); contact != nil {
+			if msg == nil {
+				msg = &MsgSetQuery{}
+			}
+			msg.Contact = &MsgSetContact{
+				User:   contact.GetUserId(),
+				Remark: contact.GetRemark(),
+				Labels: contact.GetLabels(),
+			}
+			switch contact.GetStarred() {
+			case pbx.SetContact_STAR:
+				starred := true
+				msg.Contact.Starred = &starred
+			case pbx.SetContact_UNSTAR:
+				starred := false
+				msg.Contact.Starred = &starred
+			}
+		}
 	}
 
 	return msg
 }
 
+func pbPrivacySerialize(in *MsgPrivacy) *pbx.Privacy {
+	if in == nil {
+		return nil
+	}
+	return &pbx.Privacy{
+		ContactRequests: in.ContactRequests,
+		Question:        in.Question,
+		Answer:          in.Answer,
+	}
+}
+
+func pbPrivacyDeserialize(in *pbx.Privacy) *MsgPrivacy {
+	if in == nil {
+		return nil
+	}
+	return &MsgPrivacy{
+		ContactRequests: in.GetContactRequests(),
+		Question:        in.GetQuestion(),
+		Answer:          in.GetAnswer(),
+	}
+}
+
 func pbInfoNoteWhatSerialize(what string) pbx.InfoNote {
 	var out pbx.InfoNote
 	switch what {
@@ -618,6 +1040,12 @@ func pbInfoNoteWhatSerialize(what string) pbx.InfoNote {
 		out = pbx.InfoNote_READ
 	case "recv":
 		out = pbx.InfoNote_RECV
+	case "ctread":
+		out = pbx.InfoNote_CTREAD
+	case "react":
+		out = pbx.InfoNote_REACT
+	case "unreact":
+		out = pbx.InfoNote_UNREACT
 	default:
 		log.Fatal("unknown info-note.what", what)
 	}
@@ -633,6 +1061,12 @@ func pbInfoNoteWhatDeserialize(what pbx.InfoNote) string {
 		out = "read"
 	case pbx.InfoNote_RECV:
 		out = "recv"
+	case pbx.InfoNote_CTREAD:
+		out = "ctread"
+	case pbx.InfoNote_REACT:
+		out = "react"
+	case pbx.InfoNote_UNREACT:
+		out = "unreact"
 	default:
 		log.Fatal("unknown info-note.what", what)
 	}
@@ -890,6 +1324,161 @@ func pbDelValuesDeserialize(in *pbx.DelValues) *MsgDelValues {
 	}
 }
 
+func pbContactMsgSliceSerialize(in []MsgContactMessage) []*pbx.ContactMsg {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]*pbx.ContactMsg, len(in))
+	for i := range in {
+		cm := &in[i]
+		out[i] = &pbx.ContactMsg{
+			Id:        cm.Id,
+			CreatedAt: timeToInt64(cm.CreateAt),
+			Sender:    cm.Sender,
+			Receiver:  cm.Receiver,
+			State:     int32(cm.State),
+			Public:    interfaceToBytes(cm.Public),
+			Message:   cm.Message,
+			Source:    cm.Source,
+			ExpiresAt: timeToInt64(cm.Expires),
+		}
+	}
+	return out
+}
+
+func pbContactMsgSliceDeserialize(in []*pbx.ContactMsg) []MsgContactMessage {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]MsgContactMessage, len(in))
+	for i, cm := range in {
+		out[i] = MsgContactMessage{
+			Id:       cm.GetId(),
+			CreateAt: int64ToTime(cm.GetCreatedAt()),
+			Sender:   cm.GetSender(),
+			Receiver: cm.GetReceiver(),
+			State:    int(cm.GetState()),
+			Public:   bytesToInterface(cm.GetPublic()),
+			Message:  cm.GetMessage(),
+			Source:   cm.GetSource(),
+			Expires:  int64ToTime(cm.GetExpiresAt()),
+		}
+	}
+	return out
+}
+
+func pbContactSliceSerialize(in []MsgContact) []*pbx.Contact {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]*pbx.Contact, len(in))
+	for i := range in {
+		ct := &in[i]
+		out[i] = &pbx.Contact{
+			Id:        ct.Id,
+			CreatedAt: timeToInt64(ct.CreateAt),
+			UpdatedAt: timeToInt64(ct.UpdateAt),
+			DeletedAt: timeToInt64(ct.DeleteAt),
+			UserId:    ct.User,
+			ContactId: ct.Contact,
+			Remark:    ct.Remark,
+			Labels:    ct.Labels,
+			Starred:   ct.Starred,
+			Public:    interfaceToBytes(ct.Public),
+		}
+	}
+	return out
+}
+
+func pbContactSliceDeserialize(in []*pbx.Contact) []MsgContact {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]MsgContact, len(in))
+	for i, ct := range in {
+		out[i] = MsgContact{
+			Id:       ct.GetId(),
+			CreateAt: int64ToTime(ct.GetCreatedAt()),
+			UpdateAt: int64ToTime(ct.GetUpdatedAt()),
+			DeleteAt: int64ToTime(ct.GetDeletedAt()),
+			User:     ct.GetUserId(),
+			Contact:  ct.GetContactId(),
+			Remark:   ct.GetRemark(),
+			Labels:   ct.GetLabels(),
+			Starred:  ct.GetStarred(),
+			Public:   bytesToInterface(ct.GetPublic()),
+		}
+	}
+	return out
+}
+
+func pbIceServerSliceSerialize(in []MsgIceServer) []*pbx.IceServer {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]*pbx.IceServer, len(in))
+	for i := range in {
+		srv := &in[i]
+		out[i] = &pbx.IceServer{
+			Urls:       srv.Urls,
+			Username:   srv.Username,
+			Credential: srv.Credential,
+			Expires:    timeToInt64(srv.Expires),
+		}
+	}
+	return out
+}
+
+func pbIceServerSliceDeserialize(in []*pbx.IceServer) []MsgIceServer {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]MsgIceServer, len(in))
+	for i, srv := range in {
+		out[i] = MsgIceServer{
+			Urls:       srv.GetUrls(),
+			Username:   srv.GetUsername(),
+			Credential: srv.GetCredential(),
+			Expires:    int64ToTime(srv.GetExpires()),
+		}
+	}
+	return out
+}
+
+func pbCallInfoSerialize(in *MsgCallInfo) *pbx.CallInfo {
+	if in == nil {
+		return nil
+	}
+
+	return &pbx.CallInfo{
+		Room:         in.Room,
+		Media:        in.Media,
+		State:        in.State,
+		Participants: in.Participants,
+		Started:      timeToInt64(in.Started),
+	}
+}
+
+func pbCallInfoDeserialize(in *pbx.CallInfo) *MsgCallInfo {
+	if in == nil {
+		return nil
+	}
+
+	return &MsgCallInfo{
+		Room:         in.GetRoom(),
+		Media:        in.GetMedia(),
+		State:        in.GetState(),
+		Participants: in.GetParticipants(),
+		Started:      int64ToTime(in.GetStarted()),
+	}
+}
+
 func pbCredentialsSerialize(in []MsgAccCred) []*pbx.Credential {
 	if in == nil {
 		return nil
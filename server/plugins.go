@@ -25,12 +25,15 @@ const (
 	plgSet
 	plgDel
 	plgNote
+	plgContact
+	plgSignal
 	plgData
 	plgMeta
 	plgPres
 	plgInfo
 
-	plgClientMask = plgHi | plgAcc | plgLogin | plgSub | plgLeave | plgPub | plgGet | plgSet | plgDel | plgNote
+	plgClientMask = plgHi | plgAcc | plgLogin | plgSub | plgLeave | plgPub | plgGet | plgSet | plgDel | plgNote |
+		plgContact | plgSignal
 	plgServerMask = plgData | plgMeta | plgPres | plgInfo
 
 	plgActCreate = 1 << iota
@@ -55,7 +58,7 @@ const (
 var (
 	plgPacketNames = []string{
 		"hi", "acc", "login", "sub", "leave", "pub", "get", "set", "del", "note",
-		"data", "meta", "pres", "info",
+		"contact", "signal", "data", "meta", "pres", "info",
 	}
 
 	plgTopicCatNames = []string{"me", "fnd", "p2p", "grp", "new"}
@@ -185,6 +188,8 @@ type pluginRPCFilterConfig struct {
 	Subscription *string `json:"subscription"`
 	// Filter by C.D, topic type[, exact topic name, exact user name]: "grp;CD"
 	Message *string `json:"message"`
+	// Filter by CUD: contact request created (C), rejected or agreed to (U), contact deleted (D): "CD"
+	Contact *string `json:"contact"`
 
 	// Call Find service, true or false
 	Find bool
@@ -216,6 +221,7 @@ type Plugin struct {
 	filterTopic        *PluginFilter
 	filterSubscription *PluginFilter
 	filterMessage      *PluginFilter
+	filterContact      *PluginFilter
 	filterFind         bool
 	failureCode        int
 	failureText        string
@@ -277,6 +283,10 @@ func pluginsInit(configString json.RawMessage) {
 			ParsePluginFilter(conf.Filters.Message, plgFilterByTopicType|plgFilterByAction); err != nil {
 			log.Fatal("plugins: bad Message filter", err)
 		}
+		if globals.plugins[count].filterContact, err =
+			ParsePluginFilter(conf.Filters.Contact, plgFilterByAction); err != nil {
+			log.Fatal("plugins: bad Contact filter", err)
+		}
 
 		globals.plugins[count].filterFind = conf.Filters.Find
 
@@ -614,6 +624,57 @@ func pluginMessage(data *MsgServerData, action int) {
 	}
 }
 
+// Contact request created, rejected or agreed to, or contact deleted.
+// The what is one of "add", "reject", "agree" or "del"; id is the ID of the contact request, if known.
+func pluginContact(what string, user, contact types.Uid, id string) {
+	if globals.plugins == nil {
+		return
+	}
+
+	var action int
+	switch what {
+	case "add":
+		action = plgActCreate
+	case "reject", "agree":
+		action = plgActUpd
+	case "del":
+		action = plgActDel
+	default:
+		return
+	}
+
+	var event *pbx.ContactEvent
+	for i := range globals.plugins {
+		p := &globals.plugins[i]
+		if p.filterContact == nil || p.filterContact.byAction&action == 0 {
+			// Plugin is not interested in Contact actions
+			continue
+		}
+
+		if event == nil {
+			event = &pbx.ContactEvent{
+				Action:    pluginActionToCrud(action),
+				What:      what,
+				UserId:    user.UserId(),
+				ContactId: contact.UserId(),
+				Id:        id,
+			}
+		}
+
+		var ctx context.Context
+		var cancel context.CancelFunc
+		if p.timeout > 0 {
+			ctx, cancel = context.WithTimeout(context.Background(), p.timeout)
+			defer cancel()
+		} else {
+			ctx = context.Background()
+		}
+		if _, err := p.client.Contact(ctx, event); err != nil {
+			log.Println("plugins: Contact call failed", p.name, err)
+		}
+	}
+}
+
 // Returns false to skip, true to process
 func pluginDoFiltering(filter *PluginFilter, msg *ClientComMessage) bool {
 	filterByTopic := func(topic string, flt int) bool {
@@ -679,6 +740,12 @@ func pluginDoFiltering(filter *PluginFilter, msg *ClientComMessage) bool {
 	if msg.Note != nil {
 		return filter.byPacket&plgNote != 0 && filterByTopic(msg.Note.Topic, filter.byTopicType)
 	}
+	if msg.Contact != nil {
+		return filter.byPacket&plgContact != 0 && filterByTopic(msg.Contact.Topic, filter.byTopicType)
+	}
+	if msg.Signal != nil {
+		return filter.byPacket&plgSignal != 0 && filterByTopic(msg.Signal.Topic, filter.byTopicType)
+	}
 	return false
 }
 
@@ -726,5 +793,11 @@ func pluginIDAndTopic(msg *ClientComMessage) (string, string) {
 	if msg.Note != nil {
 		return "", msg.Note.Topic
 	}
+	if msg.Contact != nil {
+		return msg.Contact.Id, msg.Contact.Topic
+	}
+	if msg.Signal != nil {
+		return msg.Signal.Id, msg.Signal.Topic
+	}
 	return "", ""
 }
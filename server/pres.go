@@ -456,29 +456,43 @@ func (t *Topic) presPubMessageCount(uid types.Uid, recv, read int, skip string)
 	}
 }
 
-func (t *Topic) presContactMessage(what string, uid types.Uid, contact types.Uid, contactId string) {
+// presContactMessage notifies the contact that the state of a contact request from the user has changed.
+func presContactMessage(what string, uid types.Uid, contact types.Uid, contactId string) {
 	globals.hub.route <- &ServerComMessage{
 		Pres: &MsgServerPres{
 			Topic:     "me",
 			What:      what,
-			Src:       t.original(uid),
+			Src:       uid.UserId(),
 			ContactId: contactId},
 		rcptto: contact.UserId()}
 }
 
-func (t *Topic) presSignal(sgAction string, room string, uid types.Uid, subs []types.Subscription) {
+// presSignal notifies subscribers of a topic other than the sender of a call or a free-form command.
+// Public data of all recipients is fetched in one call.
+func presSignal(sgAction string, room string, uid types.Uid, subs []types.Subscription) {
 	sendUser := uid.UserId()
 
+	rcpts := make([]types.Uid, 0, len(subs))
 	for i := range subs {
-		sub := subs[i]
-		user := types.ParseUid(sub.User)
-		userId := user.UserId()
-
-		if userId == sendUser {
-			continue
+		if user := types.ParseUid(subs[i].User); user != uid {
+			rcpts = append(rcpts, user)
 		}
+	}
+	if len(rcpts) == 0 {
+		return
+	}
 
-		userInfo, _ := store.Users.Get(user)
+	public := make(map[types.Uid]interface{}, len(rcpts))
+	users, err := store.Users.GetAll(rcpts...)
+	if err != nil {
+		log.Println("presSignal: failed to fetch users", sendUser, err)
+	}
+	for i := range users {
+		public[users[i].Uid()] = users[i].Public
+	}
+
+	for _, user := range rcpts {
+		userId := user.UserId()
 		globals.hub.route <- &ServerComMessage{
 			Pres: &MsgServerPres{
 				Topic:    "me",
@@ -487,11 +501,10 @@ func (t *Topic) presSignal(sgAction string, room string, uid types.Uid, subs []t
 				User:     userId,
 				SgAction: sgAction,
 				Room:     room,
-				Public:   userInfo.Public,
+				Public:   public[user],
 			},
 			rcptto: userId}
 	}
-
 }
 
 // Let other sessions of a given user know that messages are now deleted
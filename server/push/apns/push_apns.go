@@ -0,0 +1,496 @@
+// Package apns implements push notification plugin for Apple Push Notification service.
+// It uses the HTTP/2 provider API with token-based (.p8 key) authentication.
+//
+// An iOS device which supports VoIP pushes reports its device ID as "<apns token>:<pushkit token>".
+// Incoming calls are sent to the PushKit token, everything else to the APNs token.
+package apns
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tinode/chat/server/push"
+	"github.com/tinode/chat/server/store"
+	t "github.com/tinode/chat/server/store/types"
+)
+
+var handler Handler
+
+// Size of the input channel buffer.
+const defaultBuffer = 32
+
+// APNs servers.
+const (
+	hostProduction  = "https://api.push.apple.com"
+	hostDevelopment = "https://api.sandbox.push.apple.com"
+)
+
+const (
+	// APNs rejects authentication tokens older than one hour.
+	tokenRefreshInterval = 50 * time.Minute
+	// Timeout of a single request to APNs.
+	requestTimeout = 30 * time.Second
+	// Default time to live of alert notifications.
+	defaultTimeToLive = 24 * time.Hour
+	// Maximum length of apns-collapse-id in bytes.
+	maxCollapseIdLength = 64
+)
+
+// Handler represents the push handler; implements push.PushHandler interface.
+type Handler struct {
+	input  chan *push.Receipt
+	stop   chan bool
+	client *client
+}
+
+type configType struct {
+	Enabled bool `json:"enabled"`
+	Buffer  int  `json:"buffer"`
+	// Use APNs development environment.
+	Sandbox bool `json:"sandbox"`
+	// Alternative APNs server URL, such as a local stand-in for testing.
+	Host string `json:"host,omitempty"`
+	// Key ID and team ID from Apple developer account.
+	KeyId  string `json:"key_id"`
+	TeamId string `json:"team_id"`
+	// Authentication key (contents of the .p8 file) or path to the .p8 file.
+	Key     string `json:"key,omitempty"`
+	KeyFile string `json:"key_file,omitempty"`
+	// Bundle ID of the app. VoIP pushes are sent to <bundle_id>.voip.
+	BundleId string `json:"bundle_id"`
+	// Send incoming calls as VoIP pushes.
+	VoIP bool `json:"voip"`
+	// Time in seconds before an undelivered alert is discarded by APNs.
+	TimeToLive uint `json:"time_to_live,omitempty"`
+}
+
+// Init initializes the push handler
+func (Handler) Init(jsonconf string) error {
+
+	var config configType
+	err := json.Unmarshal([]byte(jsonconf), &config)
+	if err != nil {
+		return errors.New("failed to parse config: " + err.Error())
+	}
+
+	if !config.Enabled {
+		return nil
+	}
+
+	if config.KeyId == "" || config.TeamId == "" || config.BundleId == "" {
+		return errors.New("apns: missing key_id, team_id or bundle_id")
+	}
+
+	keyData := []byte(config.Key)
+	if config.Key == "" {
+		if config.KeyFile == "" {
+			return errors.New("apns: missing key")
+		}
+		if keyData, err = ioutil.ReadFile(config.KeyFile); err != nil {
+			return err
+		}
+	}
+	key, err := parsePrivateKey(keyData)
+	if err != nil {
+		return err
+	}
+
+	host := config.Host
+	if host == "" {
+		host = hostProduction
+		if config.Sandbox {
+			host = hostDevelopment
+		}
+	}
+
+	// Default transport negotiates HTTP/2 with https hosts.
+	handler.client = newClient(host, &http.Client{Timeout: requestTimeout},
+		newTokenSource(key, config.KeyId, config.TeamId))
+
+	if config.Buffer <= 0 {
+		config.Buffer = defaultBuffer
+	}
+
+	handler.input = make(chan *push.Receipt, config.Buffer)
+	handler.stop = make(chan bool, 1)
+
+	go func() {
+		for {
+			select {
+			case rcpt := <-handler.input:
+				go sendNotifications(rcpt, &config)
+			case <-handler.stop:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// parsePrivateKey parses the ECDSA key in PKCS #8 PEM format as downloaded from Apple.
+func parsePrivateKey(data []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("apns: key is not PEM encoded")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("apns: key is not ECDSA")
+	}
+	return ecKey, nil
+}
+
+// tokenSource issues JWT provider authentication tokens and caches them until they need a refresh.
+type tokenSource struct {
+	key    *ecdsa.PrivateKey
+	keyId  string
+	teamId string
+
+	lock     sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+func newTokenSource(key *ecdsa.PrivateKey, keyId, teamId string) *tokenSource {
+	return &tokenSource{key: key, keyId: keyId, teamId: teamId}
+}
+
+// get returns a cached token or signs a new one.
+func (ts *tokenSource) get(now time.Time) (string, error) {
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+
+	if ts.token != "" && now.Sub(ts.issuedAt) < tokenRefreshInterval {
+		return ts.token, nil
+	}
+
+	header, _ := json.Marshal(map[string]string{"alg": "ES256", "kid": ts.keyId})
+	claims, _ := json.Marshal(map[string]interface{}{"iss": ts.teamId, "iat": now.Unix()})
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(claims)
+
+	hash := sha256.Sum256([]byte(unsigned))
+	r, s, err := ecdsa.Sign(rand.Reader, ts.key, hash[:])
+	if err != nil {
+		return "", err
+	}
+	// ES256 signature is r and s, 32 bytes each.
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	ts.token = unsigned + "." + base64.RawURLEncoding.EncodeToString(sig)
+	ts.issuedAt = now
+	return ts.token, nil
+}
+
+// notification is a single request to APNs.
+type notification struct {
+	deviceToken string
+	// Bundle ID, with .voip suffix for VoIP pushes.
+	topic string
+	// "alert" or "voip".
+	pushType   string
+	priority   int
+	collapseId string
+	// Zero value: APNs attempts delivery only once.
+	expiration time.Time
+	payload    []byte
+}
+
+// response is the result of a request to APNs.
+type response struct {
+	StatusCode int    `json:"-"`
+	Reason     string `json:"reason"`
+}
+
+// client sends notifications to APNs.
+type client struct {
+	host   string
+	http   *http.Client
+	tokens *tokenSource
+}
+
+func newClient(host string, httpClient *http.Client, tokens *tokenSource) *client {
+	return &client{host: strings.TrimSuffix(host, "/"), http: httpClient, tokens: tokens}
+}
+
+// send posts the notification to APNs. Returns an error if the request could not be completed.
+// Notifications rejected by APNs are reported in the response.
+func (c *client) send(n *notification) (*response, error) {
+	token, err := c.tokens.get(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", c.host+"/3/device/"+n.deviceToken, bytes.NewReader(n.payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("authorization", "bearer "+token)
+	req.Header.Set("content-type", "application/json")
+	req.Header.Set("apns-push-type", n.pushType)
+	req.Header.Set("apns-topic", n.topic)
+	req.Header.Set("apns-priority", strconv.Itoa(n.priority))
+	if n.collapseId != "" {
+		req.Header.Set("apns-collapse-id", n.collapseId)
+	}
+	var expiration int64
+	if !n.expiration.IsZero() {
+		expiration = n.expiration.Unix()
+	}
+	req.Header.Set("apns-expiration", strconv.FormatInt(expiration, 10))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	result := &response{}
+	if resp.StatusCode != http.StatusOK {
+		// Error details are optional, status code is sufficient.
+		json.NewDecoder(resp.Body).Decode(result)
+	}
+	result.StatusCode = resp.StatusCode
+	return result, nil
+}
+
+// splitDeviceId splits the device ID into APNs and PushKit tokens.
+func splitDeviceId(deviceId string) (string, string) {
+	if i := strings.IndexByte(deviceId, ':'); i >= 0 {
+		return deviceId[:i], deviceId[i+1:]
+	}
+	return deviceId, ""
+}
+
+// alertPayload creates the payload of a user-visible notification. Negative badge leaves the
+// badge unchanged.
+func alertPayload(pl *push.Payload2, body string, badge int) ([]byte, error) {
+	alert := map[string]string{"body": body}
+	if pl.Title != "" {
+		alert["title"] = pl.Title
+	}
+	sound := pl.Sound
+	if sound == "" {
+		sound = "default"
+	}
+	aps := map[string]interface{}{"alert": alert, "sound": sound}
+	if badge >= 0 {
+		aps["badge"] = badge
+	}
+
+	data := make(map[string]interface{}, len(pl.Params)+1)
+	for key, val := range pl.Params {
+		data[key] = val
+	}
+	data["aps"] = aps
+	return json.Marshal(data)
+}
+
+// voipPayload creates the payload of a VoIP push. The app reports the call to CallKit by itself.
+func voipPayload(pl *push.Payload2) ([]byte, error) {
+	data := make(map[string]interface{}, len(pl.Params))
+	for key, val := range pl.Params {
+		data[key] = val
+	}
+	return json.Marshal(data)
+}
+
+// backgroundPayload creates the payload of a silent push which lets the app update its state,
+// e.g. remove the notification of a recalled message.
+func backgroundPayload(pl *push.Payload2) ([]byte, error) {
+	data := make(map[string]interface{}, len(pl.Params)+1)
+	for key, val := range pl.Params {
+		data[key] = val
+	}
+	data["aps"] = map[string]interface{}{"content-available": 1}
+	return json.Marshal(data)
+}
+
+// truncateCollapseId shortens the collapse ID to the maximum length allowed by APNs.
+func truncateCollapseId(id string) string {
+	if len(id) > maxCollapseIdLength {
+		return id[:maxCollapseIdLength]
+	}
+	return id
+}
+
+// prepareNotification creates a notification for one device. Returns nil if the device cannot
+// receive it.
+func prepareNotification(pl *push.Payload2, deviceId, lang string, badge int,
+	config *configType, now time.Time) (*notification, error) {
+
+	alertToken, voipToken := splitDeviceId(deviceId)
+	if pl.IncomingCall && config.VoIP && voipToken != "" {
+		payload, err := voipPayload(pl)
+		if err != nil {
+			return nil, err
+		}
+		// Calls are useless if delayed: no expiration.
+		return &notification{
+			deviceToken: voipToken,
+			topic:       config.BundleId + ".voip",
+			pushType:    "voip",
+			priority:    10,
+			payload:     payload,
+		}, nil
+	}
+
+	if alertToken == "" {
+		return nil, nil
+	}
+	ttl := defaultTimeToLive
+	if config.TimeToLive > 0 {
+		ttl = time.Duration(config.TimeToLive) * time.Second
+	}
+
+	if pl.Type == push.PayloadRecall {
+		payload, err := backgroundPayload(pl)
+		if err != nil {
+			return nil, err
+		}
+		// Background pushes must be sent with low priority.
+		return &notification{
+			deviceToken: alertToken,
+			topic:       config.BundleId,
+			pushType:    "background",
+			priority:    5,
+			expiration:  now.Add(ttl),
+			payload:     payload,
+		}, nil
+	}
+
+	payload, err := alertPayload(pl, pl.Localize(lang), badge)
+	if err != nil {
+		return nil, err
+	}
+	return &notification{
+		deviceToken: alertToken,
+		topic:       config.BundleId,
+		pushType:    "alert",
+		priority:    10,
+		collapseId:  truncateCollapseId(pl.CollapseId),
+		expiration:  now.Add(ttl),
+		payload:     payload,
+	}, nil
+}
+
+func sendNotifications(rcpt *push.Receipt, config *configType) {
+	// List of UIDs for querying the database
+	uids := make([]t.Uid, len(rcpt.To))
+	skipDevices := make(map[string]bool)
+	for i, to := range rcpt.To {
+		uids[i] = to.User
+
+		// Some devices were online and received the message. Skip them.
+		for _, deviceID := range to.Devices {
+			skipDevices[deviceID] = true
+		}
+	}
+
+	devices, count, err := store.Devices.GetAll(uids...)
+	if err != nil {
+		log.Println("apns push: db error", err)
+		return
+	}
+	if count == 0 {
+		return
+	}
+
+	now := time.Now()
+	for uid, devList := range devices {
+		// Number of unread messages is fetched once per user when needed.
+		badge := -1
+		for i := range devList {
+			d := &devList[i]
+			if d.Platform != "ios" || d.DeviceId == "" || skipDevices[d.DeviceId] {
+				continue
+			}
+
+			if badge < 0 && rcpt.Payload2.Type != push.PayloadRecall {
+				if unread, err := store.Users.GetUnreadCount(uid); err == nil {
+					badge = unread
+				} else {
+					log.Println("apns push: failed to get unread count", err)
+				}
+			}
+
+			n, err := prepareNotification(&rcpt.Payload2, d.DeviceId, d.Lang, badge, config, now)
+			if err != nil {
+				log.Println("apns push: failed to create payload", err)
+				return
+			}
+			if n == nil {
+				continue
+			}
+
+			resp, err := handler.client.send(n)
+			if err != nil {
+				// Network failure. Stop sending this batch.
+				log.Println("apns push: request failed", err)
+				return
+			}
+
+			switch {
+			case resp.StatusCode == http.StatusOK:
+			case resp.StatusCode == http.StatusGone:
+				// The token is no longer active. Both tokens of the device are removed, the client
+				// will report the valid ones when it connects again.
+				if err := store.Devices.Delete(uid, d.DeviceId); err != nil {
+					log.Println("apns push: failed to delete device", err)
+				}
+				log.Println("apns push: device unregistered", uid.UserId())
+			case resp.StatusCode == http.StatusForbidden:
+				// Config errors
+				log.Println("apns push: authentication failed", resp.Reason)
+				return
+			case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+				// Transient errors. Stop sending this batch.
+				log.Println("apns transient failure", resp.StatusCode, resp.Reason)
+				return
+			default:
+				log.Println("apns push:", resp.StatusCode, resp.Reason)
+			}
+		}
+	}
+}
+
+// IsReady checks if the push handler has been initialized.
+func (Handler) IsReady() bool {
+	return handler.input != nil
+}
+
+// Push return a channel that the server will use to send messages to.
+// If the adapter blocks, the message will be dropped.
+func (Handler) Push() chan<- *push.Receipt {
+	return handler.input
+}
+
+// Stop shuts down the handler
+func (Handler) Stop() {
+	handler.stop <- true
+}
+
+func init() {
+	push.Register("apns", &handler)
+}
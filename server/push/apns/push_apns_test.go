@@ -0,0 +1,244 @@
+package apns
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tinode/chat/server/push"
+)
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	key := newTestKey(t)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	parsed, err := parsePrivateKey(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.D.Cmp(key.D) != 0 {
+		t.Error("parsed key does not match")
+	}
+
+	if _, err := parsePrivateKey([]byte("not a key")); err == nil {
+		t.Error("expected error on invalid key")
+	}
+}
+
+func TestTokenSource(t *testing.T) {
+	key := newTestKey(t)
+	ts := newTokenSource(key, "KEY123", "TEAM456")
+	now := time.Now()
+
+	token, err := ts.get(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("malformed token '%s'", token)
+	}
+
+	var header map[string]string
+	raw, _ := base64.RawURLEncoding.DecodeString(parts[0])
+	if err := json.Unmarshal(raw, &header); err != nil {
+		t.Fatal(err)
+	}
+	if header["alg"] != "ES256" || header["kid"] != "KEY123" {
+		t.Errorf("unexpected header %v", header)
+	}
+
+	var claims map[string]interface{}
+	raw, _ = base64.RawURLEncoding.DecodeString(parts[1])
+	if err := json.Unmarshal(raw, &claims); err != nil {
+		t.Fatal(err)
+	}
+	if claims["iss"] != "TEAM456" || int64(claims["iat"].(float64)) != now.Unix() {
+		t.Errorf("unexpected claims %v", claims)
+	}
+
+	sig, _ := base64.RawURLEncoding.DecodeString(parts[2])
+	if len(sig) != 64 {
+		t.Fatalf("signature length %d, expected 64", len(sig))
+	}
+	hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+	if !ecdsa.Verify(&key.PublicKey, hash[:], r, s) {
+		t.Error("invalid signature")
+	}
+
+	// Token is cached until it has to be refreshed.
+	if cached, _ := ts.get(now.Add(time.Minute)); cached != token {
+		t.Error("token was not cached")
+	}
+	if fresh, _ := ts.get(now.Add(tokenRefreshInterval)); fresh == token {
+		t.Error("token was not refreshed")
+	}
+}
+
+func TestPrepareNotification(t *testing.T) {
+	config := &configType{BundleId: "co.tinode.app", VoIP: true}
+	now := time.Now()
+
+	pl := &push.Payload2{
+		Type:       push.PayloadMessage,
+		Content:    "New message",
+		Texts:      map[string]string{"de": "Neue Nachricht"},
+		CollapseId: "grpAbCdEfGhIjK",
+		Params:     map[string]interface{}{"action": "message", "topic": "grpAbCdEfGhIjK"},
+	}
+	n, err := prepareNotification(pl, "alerttoken:voiptoken", "de-DE", 7, config, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.deviceToken != "alerttoken" || n.pushType != "alert" || n.topic != "co.tinode.app" ||
+		n.collapseId != "grpAbCdEfGhIjK" || !n.expiration.Equal(now.Add(defaultTimeToLive)) {
+		t.Errorf("unexpected alert notification %+v", n)
+	}
+
+	var payload struct {
+		Aps struct {
+			Alert map[string]string `json:"alert"`
+			Badge *int              `json:"badge"`
+			Sound string            `json:"sound"`
+		} `json:"aps"`
+		Action string `json:"action"`
+		Topic  string `json:"topic"`
+	}
+	if err := json.Unmarshal(n.payload, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Aps.Alert["body"] != "Neue Nachricht" || payload.Aps.Badge == nil || *payload.Aps.Badge != 7 ||
+		payload.Aps.Sound != "default" || payload.Action != "message" || payload.Topic != "grpAbCdEfGhIjK" {
+		t.Errorf("unexpected alert payload %s", n.payload)
+	}
+
+	// Incoming calls go to the PushKit token.
+	call := &push.Payload2{
+		Type:         push.PayloadSignal,
+		Sound:        "call.caf",
+		IncomingCall: true,
+		CollapseId:   "room",
+		Params:       map[string]interface{}{"action": "signal"},
+	}
+	n, err = prepareNotification(call, "alerttoken:voiptoken", "", -1, config, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.deviceToken != "voiptoken" || n.pushType != "voip" || n.topic != "co.tinode.app.voip" ||
+		!n.expiration.IsZero() {
+		t.Errorf("unexpected voip notification %+v", n)
+	}
+
+	// Devices without a PushKit token receive an alert.
+	n, err = prepareNotification(call, "alerttoken", "", -1, config, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.deviceToken != "alerttoken" || n.pushType != "alert" || strings.Contains(string(n.payload), "badge") {
+		t.Errorf("unexpected call alert %+v", n)
+	}
+
+	// Recalls are delivered as silent background pushes.
+	recall := &push.Payload2{
+		Type:       push.PayloadRecall,
+		CollapseId: "grpAbCdEfGhIjK",
+		Params:     map[string]interface{}{"action": "recall", "seq": 10},
+	}
+	n, err = prepareNotification(recall, "alerttoken:voiptoken", "", -1, config, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.deviceToken != "alerttoken" || n.pushType != "background" || n.priority != 5 {
+		t.Errorf("unexpected recall notification %+v", n)
+	}
+	if string(n.payload) != `{"action":"recall","aps":{"content-available":1},"seq":10}` {
+		t.Errorf("unexpected recall payload %s", n.payload)
+	}
+}
+
+func TestClientSend(t *testing.T) {
+	key := newTestKey(t)
+
+	// Local stand-in for APNs.
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor != 2 {
+			t.Errorf("expected HTTP/2, got %s", r.Proto)
+		}
+		if !strings.HasPrefix(r.Header.Get("authorization"), "bearer ") {
+			t.Errorf("missing authorization")
+		}
+		if r.Header.Get("apns-topic") != "co.tinode.app" || r.Header.Get("apns-push-type") != "alert" ||
+			r.Header.Get("apns-collapse-id") != "collapse" || r.Header.Get("apns-priority") != "10" {
+			t.Errorf("unexpected headers %v", r.Header)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"aps":{}}` {
+			t.Errorf("unexpected body %s", body)
+		}
+
+		switch r.URL.Path {
+		case "/3/device/active":
+			w.Header().Set("apns-id", "5E6C4A1F-0000-0000-0000-000000000000")
+		case "/3/device/expired":
+			w.WriteHeader(http.StatusGone)
+			w.Write([]byte(`{"reason":"Unregistered","timestamp":1600000000000}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"reason":"BadDeviceToken"}`))
+		}
+	}))
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	defer srv.Close()
+
+	c := newClient(srv.URL+"/", srv.Client(), newTokenSource(key, "KEY123", "TEAM456"))
+	send := func(token string) *response {
+		resp, err := c.send(&notification{
+			deviceToken: token,
+			topic:       "co.tinode.app",
+			pushType:    "alert",
+			priority:    10,
+			collapseId:  "collapse",
+			payload:     []byte(`{"aps":{}}`),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	if resp := send("active"); resp.StatusCode != http.StatusOK {
+		t.Errorf("expected 200, got %d", resp.StatusCode)
+	}
+	if resp := send("expired"); resp.StatusCode != http.StatusGone || resp.Reason != "Unregistered" {
+		t.Errorf("expected 410 Unregistered, got %d %s", resp.StatusCode, resp.Reason)
+	}
+	if resp := send("invalid"); resp.StatusCode != http.StatusBadRequest || resp.Reason != "BadDeviceToken" {
+		t.Errorf("expected 400 BadDeviceToken, got %d %s", resp.StatusCode, resp.Reason)
+	}
+}
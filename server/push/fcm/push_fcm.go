@@ -132,7 +132,11 @@ func sendNotifications(rcpt *push.Receipt, config *configType) {
 	ctx := context.Background()
 
 	data, _ := payloadToData(&rcpt.Payload)
-	if data == nil || data["content"] == "" {
+	recall := rcpt.Payload2.Type == push.PayloadRecall
+	if recall && data != nil {
+		// Data-only message telling the app to remove the notification of the recalled message.
+		data["what"] = "recall"
+	} else if data == nil || data["content"] == "" {
 		log.Println("fcm push: could not parse payload or empty payload")
 		return
 	}
@@ -170,7 +174,7 @@ func sendNotifications(rcpt *push.Receipt, config *configType) {
 					msg.Android = &fcm.AndroidConfig{
 						Priority: "high",
 					}
-					if config.IncludeAndroidNotification {
+					if config.IncludeAndroidNotification && !recall {
 						msg.Android.Notification = &fcm.AndroidNotification{
 							Title: "New message",
 							Body:  data["content"],
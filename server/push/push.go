@@ -5,6 +5,7 @@ package push
 import (
 	"encoding/json"
 	"errors"
+	"strings"
 	"time"
 
 	t "github.com/tinode/chat/server/store/types"
@@ -49,6 +50,8 @@ const (
 	PayloadContact
 	// Contact Signal
 	PayloadSignal
+	// Message recalled by the sender. Devices should remove the notification of the message.
+	PayloadRecall
 )
 
 // only support ios and android
@@ -60,14 +63,38 @@ const (
 	ALL
 )
 
+// Payload2 is content of the push independent of the vendor.
 type Payload2 struct {
-	Type    PayloadType
-	Plat    Platform
-	Title   string
-	Content string
-	Sound   string
+	Type    PayloadType `json:"type"`
+	Plat    Platform    `json:"plat"`
+	Title   string      `json:"title,omitempty"`
+	Content string      `json:"content,omitempty"`
+	// Content translated to other languages, keyed by language code
+	Texts map[string]string `json:"texts,omitempty"`
+	Sound string            `json:"sound,omitempty"`
+	// Notifications with the same collapse ID replace each other on the device
+	CollapseId string `json:"collapse,omitempty"`
+	// Notification of an incoming call which may be delivered as a VoIP push
+	IncomingCall bool `json:"call,omitempty"`
 	// Client handler params
-	Params map[string]interface{}
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+// Localize returns Content translated to the given language, such as "en" or "en-US". Falls back
+// to the base language then to Content.
+func (p *Payload2) Localize(lang string) string {
+	if lang == "" || len(p.Texts) == 0 {
+		return p.Content
+	}
+	if text, ok := p.Texts[lang]; ok {
+		return text
+	}
+	if i := strings.IndexAny(lang, "-_"); i > 0 {
+		if text, ok := p.Texts[lang[:i]]; ok {
+			return text
+		}
+	}
+	return p.Content
 }
 
 // Handler is an interface which must be implemented by handlers.
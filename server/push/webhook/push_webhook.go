@@ -0,0 +1,239 @@
+// Package webhook implements push notification plugin which posts push receipts as JSON to
+// configured URLs, such as a custom notification gateway. Each recipient of a receipt is posted
+// in a separate request with the recipient alone in the "To" list.
+//
+// Each request is signed with HMAC-SHA256 of "<timestamp>.<body>" using the shared secret.
+// The timestamp (Unix seconds) is sent in the X-Tinode-Timestamp header, the hex-encoded signature
+// in the X-Tinode-Signature header.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/tinode/chat/server/push"
+)
+
+var handler Handler
+
+const (
+	// Size of the input channel buffer.
+	defaultBuffer = 32
+	// Number of goroutines posting notifications.
+	defaultWorkers = 4
+	// Maximum number of attempts to deliver one request.
+	defaultMaxAttempts = 3
+	// Delay before the first retry. Doubled with each attempt.
+	defaultRetryDelay = 500 * time.Millisecond
+	// Timeout of a single request.
+	defaultTimeout = 10 * time.Second
+)
+
+// Handler represents the push handler; implements push.PushHandler interface.
+type Handler struct {
+	input  chan *push.Receipt
+	stop   chan bool
+	sender *sender
+}
+
+type configType struct {
+	Enabled bool `json:"enabled"`
+	Buffer  int  `json:"buffer"`
+	// URLs to post notifications to. Each URL receives every notification.
+	URLs []string `json:"urls"`
+	// Shared secret for signing requests. Requests are not signed if the secret is empty.
+	Secret string `json:"secret"`
+	// Number of goroutines posting notifications.
+	Workers int `json:"workers"`
+	// Maximum number of delivery attempts per request.
+	MaxAttempts int `json:"max_attempts"`
+	// Delay in milliseconds before the first retry. Doubled with each attempt.
+	RetryDelay int `json:"retry_delay"`
+	// Request timeout in seconds.
+	Timeout int `json:"timeout"`
+}
+
+// Init initializes the push handler
+func (Handler) Init(jsonconf string) error {
+
+	var config configType
+	if err := json.Unmarshal([]byte(jsonconf), &config); err != nil {
+		return errors.New("failed to parse config: " + err.Error())
+	}
+
+	if !config.Enabled {
+		return nil
+	}
+
+	if len(config.URLs) == 0 {
+		return errors.New("webhook: missing urls")
+	}
+	if config.Buffer <= 0 {
+		config.Buffer = defaultBuffer
+	}
+	if config.Workers <= 0 {
+		config.Workers = defaultWorkers
+	}
+
+	handler.sender = newSender(&config)
+	handler.input = make(chan *push.Receipt, config.Buffer)
+	handler.stop = make(chan bool)
+
+	for i := 0; i < config.Workers; i++ {
+		go func() {
+			for {
+				select {
+				case rcpt := <-handler.input:
+					handler.sender.deliver(rcpt, handler.stop)
+				case <-handler.stop:
+					return
+				}
+			}
+		}()
+	}
+
+	return nil
+}
+
+// sender posts receipts to the configured URLs.
+type sender struct {
+	urls        []string
+	secret      []byte
+	maxAttempts int
+	retryDelay  time.Duration
+	client      *http.Client
+}
+
+func newSender(config *configType) *sender {
+	s := &sender{
+		urls:        config.URLs,
+		secret:      []byte(config.Secret),
+		maxAttempts: config.MaxAttempts,
+		retryDelay:  time.Duration(config.RetryDelay) * time.Millisecond,
+		client:      &http.Client{Timeout: time.Duration(config.Timeout) * time.Second},
+	}
+	if s.maxAttempts <= 0 {
+		s.maxAttempts = defaultMaxAttempts
+	}
+	if s.retryDelay <= 0 {
+		s.retryDelay = defaultRetryDelay
+	}
+	if s.client.Timeout <= 0 {
+		s.client.Timeout = defaultTimeout
+	}
+	return s
+}
+
+// perRecipient splits the receipt into receipts with one recipient each.
+func perRecipient(rcpt *push.Receipt) []*push.Receipt {
+	single := make([]*push.Receipt, len(rcpt.To))
+	for i := range rcpt.To {
+		one := *rcpt
+		one.To = rcpt.To[i : i+1]
+		single[i] = &one
+	}
+	return single
+}
+
+// deliver posts the receipt to all URLs, one request per recipient. Gives up retrying when stop is closed.
+func (s *sender) deliver(rcpt *push.Receipt, stop <-chan bool) {
+	for _, one := range perRecipient(rcpt) {
+		body, err := json.Marshal(one)
+		if err != nil {
+			log.Println("webhook push: failed to serialize receipt", err)
+			return
+		}
+		for _, url := range s.urls {
+			if err := s.post(url, body, stop); err != nil {
+				log.Println("webhook push:", url, err)
+			}
+		}
+	}
+}
+
+// post sends the body to the URL, retrying with exponential backoff on network errors,
+// 429 and 5xx responses.
+func (s *sender) post(url string, body []byte, stop <-chan bool) error {
+	delay := s.retryDelay
+	var err error
+	for attempt := 1; ; attempt++ {
+		var retry bool
+		if retry, err = s.postOnce(url, body); err == nil || !retry || attempt >= s.maxAttempts {
+			return err
+		}
+
+		select {
+		case <-time.After(delay):
+			delay *= 2
+		case <-stop:
+			return err
+		}
+	}
+}
+
+// postOnce makes one attempt to deliver the body. Returns true if the request should be retried.
+func (s *sender) postOnce(url string, body []byte) (bool, error) {
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(s.secret) > 0 {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set("X-Tinode-Timestamp", timestamp)
+		req.Header.Set("X-Tinode-Signature", sign(s.secret, timestamp, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	// Drain the body so the connection can be reused.
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	err = errors.New("unexpected response " + resp.Status)
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500, err
+}
+
+// sign computes hex-encoded HMAC-SHA256 of "<timestamp>.<body>".
+func sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// IsReady checks if the push handler has been initialized.
+func (Handler) IsReady() bool {
+	return handler.input != nil
+}
+
+// Push return a channel that the server will use to send messages to.
+// If the adapter blocks, the message will be dropped.
+func (Handler) Push() chan<- *push.Receipt {
+	return handler.input
+}
+
+// Stop shuts down the handler. Pending retries are abandoned.
+func (Handler) Stop() {
+	close(handler.stop)
+}
+
+func init() {
+	push.Register("webhook", &handler)
+}
@@ -0,0 +1,109 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/tinode/chat/server/push"
+	t "github.com/tinode/chat/server/store/types"
+)
+
+func newTestReceipt(count int) *push.Receipt {
+	rcpt := &push.Receipt{
+		Payload: push.Payload{Topic: "grpAbCdEfGhIjK", SeqId: 10},
+		Payload2: push.Payload2{
+			Type:    push.PayloadMessage,
+			Content: "New message",
+			Params:  map[string]interface{}{"action": "message"},
+		},
+	}
+	for i := 0; i < count; i++ {
+		rcpt.To = append(rcpt.To, push.Recipient{User: t.Uid(i + 1)})
+	}
+	return rcpt
+}
+
+func TestPerRecipient(tt *testing.T) {
+	receipts := perRecipient(newTestReceipt(3))
+	if len(receipts) != 3 {
+		tt.Fatalf("expected 3 receipts, got %d", len(receipts))
+	}
+	for i, rcpt := range receipts {
+		if len(rcpt.To) != 1 || rcpt.To[0].User != t.Uid(i+1) {
+			tt.Errorf("receipt %d: unexpected recipients %+v", i, rcpt.To)
+		}
+		if rcpt.Payload2.Content != "New message" {
+			tt.Errorf("receipt %d: payload is missing", i)
+		}
+	}
+}
+
+func TestDeliver(tt *testing.T) {
+	var lock sync.Mutex
+	var attempts int
+	var received []push.Receipt
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		attempts++
+		if attempts == 1 {
+			// The first attempt fails and must be retried.
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		timestamp := r.Header.Get("X-Tinode-Timestamp")
+		if r.Header.Get("X-Tinode-Signature") != sign([]byte("secret"), timestamp, body) {
+			tt.Error("invalid signature")
+		}
+
+		var rcpt push.Receipt
+		if err := json.Unmarshal(body, &rcpt); err != nil {
+			tt.Error(err)
+		}
+		received = append(received, rcpt)
+	}))
+	defer srv.Close()
+
+	s := newSender(&configType{
+		URLs:       []string{srv.URL},
+		Secret:     "secret",
+		RetryDelay: 1,
+	})
+	s.deliver(newTestReceipt(2), make(chan bool))
+
+	if attempts != 3 {
+		tt.Errorf("expected 3 attempts, got %d", attempts)
+	}
+	if len(received) != 2 || len(received[0].To) != 1 || received[0].To[0].User != 1 ||
+		len(received[1].To) != 1 || received[1].To[0].User != 2 {
+		tt.Fatalf("unexpected requests %+v", received)
+	}
+	if received[0].Payload.Topic != "grpAbCdEfGhIjK" || received[0].Payload2.Params["action"] != "message" {
+		tt.Errorf("unexpected payload %+v", received[0])
+	}
+}
+
+func TestNoRetryOnClientError(tt *testing.T) {
+	var attempts int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	s := newSender(&configType{URLs: []string{srv.URL}, RetryDelay: 1})
+	if err := s.post(srv.URL, []byte("{}"), make(chan bool)); err == nil {
+		tt.Error("expected error")
+	}
+	if attempts != 1 {
+		tt.Errorf("expected 1 attempt, got %d", attempts)
+	}
+}
@@ -125,6 +125,11 @@ func (XgPush) Init(jsonconf string) error {
 }
 
 func sendNotifications(rcpt *push.Receipt) {
+	if rcpt.Payload2.Type == push.PayloadRecall {
+		// XG pushes are always displayed to the user, recalls cannot be delivered silently.
+		return
+	}
+
 	uids := make([]t.Uid, len(rcpt.To))
 	skipDevices := make(map[string]bool)
 	for i, to := range rcpt.To {
@@ -149,11 +154,14 @@ func sendNotifications(rcpt *push.Receipt) {
 		for i := range devList {
 			d := &devList[i]
 			if _, ok := skipDevices[d.DeviceId]; !ok && d.DeviceId != "" {
+				// Use text in the language of the device.
+				pl := rcpt.Payload2
+				pl.Content = pl.Localize(d.Lang)
 				switch d.Platform {
 				case "ios":
-					pushIos(d.DeviceId, &rcpt.Payload2)
+					pushIos(d.DeviceId, &pl)
 				case "android":
-					pushAndroid(d.DeviceId, &rcpt.Payload2)
+					pushAndroid(d.DeviceId, &pl)
 				}
 			}
 		}
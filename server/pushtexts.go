@@ -0,0 +1,238 @@
+/******************************************************************************
+ *
+ *  Description:
+ *
+ *  Localized texts of push notifications. Texts are text/template templates keyed
+ *  by event and language. The name of the sender is available as {{.Name}}.
+ *
+ *****************************************************************************/
+
+package main
+
+import (
+	"bytes"
+	"container/list"
+	"errors"
+	"log"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/tinode/chat/server/store"
+	"github.com/tinode/chat/server/store/types"
+)
+
+// Events which generate push notifications.
+const (
+	pushEventMessage         = "message"
+	pushEventContactRequest  = "contact_request"
+	pushEventContactRejected = "contact_rejected"
+	pushEventContactAccepted = "contact_accepted"
+	pushEventCallIncoming    = "call_incoming"
+	pushEventCallMissed      = "call_missed"
+	pushEventCallCancelled   = "call_cancelled"
+)
+
+// Language of push texts when the device language is unknown or not translated.
+const defaultPushLang = "zh"
+
+// Number of sender names to keep in cache.
+const senderNameCacheSize = 1024
+
+// Time after which a cached sender name is loaded again.
+const senderNameCacheTTL = 10 * time.Minute
+
+// Built-in texts, may be overridden in the config file.
+var defaultPushTexts = map[string]map[string]string{
+	pushEventMessage: {
+		"zh": "{{.Name}}给你发来一条消息",
+		"en": "New message from {{.Name}}",
+	},
+	pushEventContactRequest: {
+		"zh": "{{.Name}}请求添加你为好友",
+		"en": "{{.Name}} wants to add you as a contact",
+	},
+	pushEventContactRejected: {
+		"zh": "{{.Name}}拒绝了你的好友请求",
+		"en": "{{.Name}} declined your contact request",
+	},
+	pushEventContactAccepted: {
+		"zh": "{{.Name}}同意了你的好友请求",
+		"en": "{{.Name}} accepted your contact request",
+	},
+	pushEventCallIncoming: {
+		"zh": "{{.Name}}邀请你通话",
+		"en": "Incoming call from {{.Name}}",
+	},
+	pushEventCallMissed: {
+		"zh": "你有一个来自{{.Name}}的未接来电",
+		"en": "Missed call from {{.Name}}",
+	},
+	pushEventCallCancelled: {
+		"zh": "{{.Name}}已经取消通话",
+		"en": "{{.Name}} cancelled the call",
+	},
+}
+
+// pushTextsConfig is the configuration of push notification texts.
+type pushTextsConfig struct {
+	// Language to use when the device language is unknown or there is no translation.
+	DefaultLang string `json:"default_lang"`
+	// Templates keyed by event then by language, e.g. {"message": {"en": "New message from {{.Name}}"}}.
+	Templates map[string]map[string]string `json:"templates"`
+}
+
+// pushTextCatalog renders texts of push notifications.
+type pushTextCatalog struct {
+	defaultLang string
+	// Parsed templates indexed by event then by language.
+	templates map[string]map[string]*template.Template
+
+	// Cache of sender names, most recently used in front.
+	namesLock sync.Mutex
+	namesLru  *list.List
+	names     map[types.Uid]*list.Element
+}
+
+// senderNameEntry is a cached name of the sender.
+type senderNameEntry struct {
+	uid    types.Uid
+	name   string
+	loaded time.Time
+}
+
+func newPushTextCatalog(conf *pushTextsConfig) (*pushTextCatalog, error) {
+	cat := &pushTextCatalog{
+		defaultLang: defaultPushLang,
+		templates:   make(map[string]map[string]*template.Template),
+		namesLru:    list.New(),
+		names:       make(map[types.Uid]*list.Element),
+	}
+
+	texts := defaultPushTexts
+	if conf != nil {
+		if conf.DefaultLang != "" {
+			cat.defaultLang = conf.DefaultLang
+		}
+		// Merge configured texts into the built-in ones.
+		texts = make(map[string]map[string]string)
+		for event, langs := range defaultPushTexts {
+			texts[event] = make(map[string]string)
+			for lang, text := range langs {
+				texts[event][lang] = text
+			}
+		}
+		for event, langs := range conf.Templates {
+			if texts[event] == nil {
+				texts[event] = make(map[string]string)
+			}
+			for lang, text := range langs {
+				texts[event][lang] = text
+			}
+		}
+	}
+
+	for event, langs := range texts {
+		cat.templates[event] = make(map[string]*template.Template)
+		for lang, text := range langs {
+			tmpl, err := template.New(event + "." + lang).Parse(text)
+			if err != nil {
+				return nil, errors.New("push texts: invalid template '" + event + "." + lang + "': " + err.Error())
+			}
+			cat.templates[event][lang] = tmpl
+		}
+	}
+
+	return cat, nil
+}
+
+// render returns the text of the event in the default language and translations to all
+// available languages. The sender's name is taken from Public.fn.
+func (c *pushTextCatalog) render(event string, from types.Uid) (string, map[string]string) {
+	langs := c.templates[event]
+	if len(langs) == 0 {
+		return "", nil
+	}
+
+	data := struct{ Name string }{Name: c.senderName(from)}
+
+	texts := make(map[string]string, len(langs))
+	var buf bytes.Buffer
+	for lang, tmpl := range langs {
+		buf.Reset()
+		if err := tmpl.Execute(&buf, &data); err != nil {
+			log.Println("push texts: failed to render", event, lang, err)
+			continue
+		}
+		texts[lang] = buf.String()
+	}
+
+	content, ok := texts[c.defaultLang]
+	if !ok {
+		// Default language is not translated, use any.
+		for _, text := range texts {
+			content = text
+			break
+		}
+	}
+
+	return content, texts
+}
+
+// senderName returns the full name of the user from Public.fn or an empty string.
+// Names are cached to avoid loading the user for every notification.
+func (c *pushTextCatalog) senderName(uid types.Uid) string {
+	if uid.IsZero() {
+		return ""
+	}
+
+	now := time.Now()
+	c.namesLock.Lock()
+	if elem, ok := c.names[uid]; ok {
+		entry := elem.Value.(*senderNameEntry)
+		if now.Sub(entry.loaded) < senderNameCacheTTL {
+			c.namesLru.MoveToFront(elem)
+			c.namesLock.Unlock()
+			return entry.name
+		}
+	}
+	c.namesLock.Unlock()
+
+	var name string
+	user, err := store.Users.Get(uid)
+	if err != nil {
+		// Don't cache the failure.
+		return ""
+	}
+	if user != nil {
+		if public, ok := user.Public.(map[string]interface{}); ok {
+			name, _ = public["fn"].(string)
+		}
+	}
+
+	c.namesLock.Lock()
+	defer c.namesLock.Unlock()
+	if elem, ok := c.names[uid]; ok {
+		entry := elem.Value.(*senderNameEntry)
+		entry.name, entry.loaded = name, now
+		c.namesLru.MoveToFront(elem)
+		return name
+	}
+	c.names[uid] = c.namesLru.PushFront(&senderNameEntry{uid: uid, name: name, loaded: now})
+	if c.namesLru.Len() > senderNameCacheSize {
+		oldest := c.namesLru.Back()
+		c.namesLru.Remove(oldest)
+		delete(c.names, oldest.Value.(*senderNameEntry).uid)
+	}
+	return name
+}
+
+// forgetSender removes the cached name of the user, e.g. when the user has changed Public.
+func (c *pushTextCatalog) forgetSender(uid types.Uid) {
+	c.namesLock.Lock()
+	defer c.namesLock.Unlock()
+	if elem, ok := c.names[uid]; ok {
+		c.namesLru.Remove(elem)
+		delete(c.names, uid)
+	}
+}
@@ -0,0 +1,86 @@
+/******************************************************************************
+ *
+ *  Description:
+ *
+ *  Emoji reactions to messages.
+ *
+ *****************************************************************************/
+
+package main
+
+import (
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tinode/chat/server/store"
+	"github.com/tinode/chat/server/store/types"
+)
+
+// Maximum length of a reaction in bytes. Long enough for emoji ZWJ sequences.
+const maxReactionLength = 32
+
+// Maximum number of different reactions of one user to one message.
+const maxUserReactions = 10
+
+// Maximum number of different emoji in reactions to one message.
+const maxMessageReactions = 50
+
+// validReaction checks if the string can be used as a reaction.
+func validReaction(emoji string) bool {
+	return emoji != "" && len(emoji) <= maxReactionLength && utf8.ValidString(emoji) &&
+		!strings.ContainsAny(emoji, " \t\r\n")
+}
+
+// replyReaction adds or removes the reaction of the {note} sender to the message. Returns true if
+// the reaction has changed and should be broadcast to subscribers.
+func (t *Topic) replyReaction(info *MsgServerInfo) bool {
+	if info.SeqId > t.lastID {
+		// Drop bogus reaction
+		return false
+	}
+
+	uid := types.ParseUserId(info.From)
+	pud := t.perUser[uid]
+	// Users can react only to messages they can read.
+	if !(pud.modeGiven & pud.modeWant).IsReader() {
+		return false
+	}
+
+	var changed bool
+	var err error
+	if info.What == "react" {
+		var mm *types.Message
+		if mm, err = store.Messages.Get(t.name, info.SeqId); err == nil {
+			if mm == nil || mm.DelId > 0 {
+				// Deleted messages cannot be reacted to.
+				return false
+			}
+			changed, err = store.Reactions.Add(t.name, info.SeqId, uid, info.Emoji,
+				maxUserReactions, maxMessageReactions)
+		}
+	} else {
+		changed, err = store.Reactions.Delete(t.name, info.SeqId, uid, info.Emoji)
+	}
+
+	if err == types.ErrPolicy {
+		// Too many reactions: drop.
+		return false
+	} else if err != nil {
+		log.Printf("topic[%s]: failed to update reaction: %v", t.name, err)
+		return false
+	}
+	return changed
+}
+
+// messageReactions converts reaction counts of a stored message to wire format.
+func messageReactions(counts []types.ReactionCount) []MsgReaction {
+	if len(counts) == 0 {
+		return nil
+	}
+	out := make([]MsgReaction, len(counts))
+	for i := range counts {
+		out[i] = MsgReaction{Emoji: counts[i].Emoji, Count: counts[i].Count, Mine: counts[i].Mine}
+	}
+	return out
+}
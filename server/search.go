@@ -0,0 +1,142 @@
+/******************************************************************************
+ *
+ *  Description:
+ *
+ *  Full-text search of messages.
+ *
+ *****************************************************************************/
+
+package main
+
+import (
+	"errors"
+
+	"github.com/tinode/chat/server/drafty"
+	"github.com/tinode/chat/server/search"
+	"github.com/tinode/chat/server/store"
+	"github.com/tinode/chat/server/store/types"
+)
+
+const (
+	// Default number of messages returned by a search.
+	defaultSearchResults = 20
+	// Maximum number of messages returned by a search.
+	maxSearchResults = 100
+	// Maximum length of the text fragment with highlighted words, in characters.
+	searchFragmentLength = 120
+)
+
+// replyGetSearch finds messages which contain all words of the query. The 'me' topic searches
+// all topics which the user can read, other topics search their own messages.
+func (t *Topic) replyGetSearch(sess *Session, asUid types.Uid, id string, req *MsgGetOpts) error {
+	now := types.TimeNow()
+	toriginal := t.original(asUid)
+
+	if req == nil || len(search.Terms(req.Query)) == 0 || req.IfModifiedSince != nil || req.User != "" ||
+		req.Topic != "" || req.Thread != 0 ||
+		(t.cat == types.TopicCatMe && (req.SinceId != 0 || req.BeforeId != 0)) {
+		sess.queueOut(ErrMalformed(id, toriginal, now))
+		return errors.New("invalid MsgGetOpts query")
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSearchResults
+	} else if limit > maxSearchResults {
+		limit = maxSearchResults
+	}
+
+	// Topics to search and the names of the topics as seen by the user.
+	var scopes []types.SearchScope
+	names := make(map[string]string)
+	if t.cat == types.TopicCatMe {
+		subs, err := store.Users.GetTopics(asUid, nil)
+		if err != nil {
+			sess.queueOut(decodeStoreError(err, id, toriginal, now, nil))
+			return err
+		}
+		scopes, names = searchScopes(subs, req.Read)
+	} else {
+		userData := t.perUser[asUid]
+		if !(userData.modeGiven & userData.modeWant).IsReader() {
+			sess.queueOut(ErrPermissionDenied(id, toriginal, now))
+			return errors.New("user does not have R permission")
+		}
+
+		scope := types.SearchScope{Topic: t.name, Since: req.SinceId, Before: req.BeforeId}
+		if req.Read && (scope.Before <= 0 || scope.Before > userData.readID+1) {
+			scope.Before = userData.readID + 1
+		}
+		scopes = append(scopes, scope)
+		names[t.name] = toriginal
+	}
+
+	var hits []MsgSearchHit
+	if len(scopes) > 0 {
+		messages, err := store.Messages.Search(asUid, req.Query, scopes, limit)
+		if err != nil {
+			sess.queueOut(decodeStoreError(err, id, toriginal, now, nil))
+			return err
+		}
+
+		for i := range messages {
+			mm := &messages[i]
+			txt, err := drafty.ToPlainText(mm.Content)
+			if err != nil {
+				continue
+			}
+			fragment, spans := search.Highlight(txt, req.Query, searchFragmentLength)
+			hit := MsgSearchHit{
+				Topic:     names[mm.Topic],
+				SeqId:     mm.SeqId,
+				From:      types.ParseUid(mm.From).UserId(),
+				Timestamp: mm.CreatedAt,
+				Text:      fragment}
+			for _, sp := range spans {
+				hit.Highlights = append(hit.Highlights, MsgHighlight{At: sp.At, Len: sp.Len})
+			}
+			hits = append(hits, hit)
+		}
+	}
+
+	if len(hits) > 0 {
+		sess.queueOut(&ServerComMessage{Meta: &MsgServerMeta{
+			Id:        id,
+			Topic:     toriginal,
+			Search:    hits,
+			Timestamp: &now}})
+		return nil
+	}
+
+	reply := NoErr(id, toriginal, now)
+	reply.Ctrl.Params = map[string]string{"what": "search"}
+	sess.queueOut(reply)
+
+	return nil
+}
+
+// searchScopes returns the topics which the user can read and the names of the topics as seen by
+// the user. If read is true, only messages already read by the user are searched.
+func searchScopes(subs []types.Subscription, read bool) ([]types.SearchScope, map[string]string) {
+	var scopes []types.SearchScope
+	names := make(map[string]string)
+	for i := range subs {
+		sub := &subs[i]
+		if !(sub.ModeGiven & sub.ModeWant).IsReader() {
+			continue
+		}
+		scope := types.SearchScope{Topic: sub.Topic}
+		if read {
+			scope.Before = sub.ReadSeqId + 1
+		}
+		scopes = append(scopes, scope)
+
+		// P2P topic name is the UID of the other user.
+		if with := sub.GetWith(); with != "" {
+			names[sub.Topic] = with
+		} else {
+			names[sub.Topic] = sub.Topic
+		}
+	}
+	return scopes, names
+}
@@ -0,0 +1,7 @@
+// +build !bleve
+
+// This file is needed for conditional compilation. It's used when
+// the build tag 'bleve' is not defined. Otherwise the index.go
+// is compiled.
+
+package bleve
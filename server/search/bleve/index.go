@@ -0,0 +1,225 @@
+// +build bleve
+
+// Package bleve implements github.com/tinode/chat/server/search interface using the embedded
+// Bleve full-text index stored in a local directory.
+// The index cannot be shared between processes, thus it's suitable for single-node deployments only.
+package bleve
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/tinode/chat/server/search"
+	"github.com/tinode/chat/server/store"
+	t "github.com/tinode/chat/server/store/types"
+)
+
+const (
+	indexName = "bleve"
+
+	// Number of documents to remove from the index in one batch when deleting a topic.
+	deleteBatchSize = 1000
+)
+
+type configType struct {
+	// Directory where the index is stored. It's created if missing.
+	IndexPath string `json:"index_path"`
+}
+
+// Indexed document. Field names are taken from json tags.
+type document struct {
+	Topic     string    `json:"topic"`
+	SeqId     int       `json:"seq"`
+	CreatedAt time.Time `json:"ts"`
+	Text      string    `json:"text"`
+}
+
+type bleveIndex struct {
+	index bleve.Index
+}
+
+// Init opens the index or creates a new one.
+func (bi *bleveIndex) Init(jsonconf string) error {
+	if bi.index != nil {
+		return errors.New("index already initialized")
+	}
+
+	var config configType
+	if err := json.Unmarshal([]byte(jsonconf), &config); err != nil {
+		return errors.New("failed to parse config: " + err.Error())
+	}
+
+	if config.IndexPath == "" {
+		return errors.New("missing index path")
+	}
+
+	index, err := bleve.Open(config.IndexPath)
+	if err == bleve.ErrorIndexPathDoesNotExist {
+		index, err = bleve.New(config.IndexPath, newMapping())
+	}
+	if err != nil {
+		return err
+	}
+
+	bi.index = index
+	return nil
+}
+
+// Add indexes text of the message.
+func (bi *bleveIndex) Add(topic string, seqId int, ts time.Time, text string) error {
+	return bi.index.Index(docId(topic, seqId), document{Topic: topic, SeqId: seqId, CreatedAt: ts, Text: text})
+}
+
+// Delete removes messages from the index.
+func (bi *bleveIndex) Delete(topic string, ranges []t.Range) error {
+	if ranges == nil {
+		// Find all documents of the topic and delete them batch by batch.
+		tq := bleve.NewTermQuery(topic)
+		tq.SetField("topic")
+		for {
+			res, err := bi.index.Search(bleve.NewSearchRequestOptions(tq, deleteBatchSize, 0, false))
+			if err != nil {
+				return err
+			}
+			if len(res.Hits) == 0 {
+				return nil
+			}
+
+			batch := bi.index.NewBatch()
+			for _, hit := range res.Hits {
+				batch.Delete(hit.ID)
+			}
+			if err = bi.index.Batch(batch); err != nil {
+				return err
+			}
+		}
+	}
+
+	batch := bi.index.NewBatch()
+	for _, r := range ranges {
+		hi := r.Hi
+		if hi == 0 {
+			hi = r.Low + 1
+		}
+		for seq := r.Low; seq < hi; seq++ {
+			batch.Delete(docId(topic, seq))
+		}
+	}
+	return bi.index.Batch(batch)
+}
+
+// Search finds messages which contain all words of the query.
+func (bi *bleveIndex) Search(text string, scopes []t.SearchScope, offset, limit int) ([]search.Hit, error) {
+	if len(scopes) == 0 || len(search.Terms(text)) == 0 {
+		return nil, nil
+	}
+
+	match := bleve.NewMatchQuery(text)
+	match.SetField("text")
+	match.SetOperator(query.MatchQueryOperatorAnd)
+
+	scoped := make([]query.Query, len(scopes))
+	for i, scope := range scopes {
+		tq := bleve.NewTermQuery(scope.Topic)
+		tq.SetField("topic")
+		if scope.Since <= 0 && scope.Before <= 0 {
+			scoped[i] = tq
+			continue
+		}
+
+		// Seq ID range is [Since, Before), open ends are nil.
+		var min, max *float64
+		if scope.Since > 0 {
+			val := float64(scope.Since)
+			min = &val
+		}
+		if scope.Before > 0 {
+			val := float64(scope.Before)
+			max = &val
+		}
+		inclusive, exclusive := true, false
+		rq := bleve.NewNumericRangeInclusiveQuery(min, max, &inclusive, &exclusive)
+		rq.SetField("seq")
+		scoped[i] = bleve.NewConjunctionQuery(tq, rq)
+	}
+
+	req := bleve.NewSearchRequestOptions(bleve.NewConjunctionQuery(match, bleve.NewDisjunctionQuery(scoped...)),
+		limit, offset, false)
+	req.SortBy([]string{"-ts"})
+
+	res, err := bi.index.Search(req)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]search.Hit, 0, len(res.Hits))
+	for _, doc := range res.Hits {
+		if topic, seqId := parseDocId(doc.ID); seqId > 0 {
+			hits = append(hits, search.Hit{Topic: topic, SeqId: seqId})
+		}
+	}
+	return hits, nil
+}
+
+// Close closes the index.
+func (bi *bleveIndex) Close() error {
+	if bi.index == nil {
+		return nil
+	}
+	err := bi.index.Close()
+	bi.index = nil
+	return err
+}
+
+// Topic names are matched exactly, text is split into words and CJK bigrams.
+func newMapping() mapping.IndexMapping {
+	keywordField := bleve.NewTextFieldMapping()
+	keywordField.Analyzer = keyword.Name
+	keywordField.IncludeInAll = false
+
+	seqField := bleve.NewNumericFieldMapping()
+	seqField.IncludeInAll = false
+
+	tsField := bleve.NewDateTimeFieldMapping()
+	tsField.IncludeInAll = false
+
+	textField := bleve.NewTextFieldMapping()
+	textField.Analyzer = cjk.AnalyzerName
+	textField.IncludeInAll = false
+
+	doc := bleve.NewDocumentStaticMapping()
+	doc.AddFieldMappingsAt("topic", keywordField)
+	doc.AddFieldMappingsAt("seq", seqField)
+	doc.AddFieldMappingsAt("ts", tsField)
+	doc.AddFieldMappingsAt("text", textField)
+
+	im := bleve.NewIndexMapping()
+	im.DefaultMapping = doc
+	return im
+}
+
+// Document ID is the topic name and message seq ID separated by a colon.
+func docId(topic string, seqId int) string {
+	return topic + ":" + strconv.Itoa(seqId)
+}
+
+func parseDocId(id string) (string, int) {
+	i := strings.LastIndexByte(id, ':')
+	if i < 0 {
+		return "", 0
+	}
+	seqId, _ := strconv.Atoi(id[i+1:])
+	return id[:i], seqId
+}
+
+func init() {
+	store.RegisterSearchIndex(indexName, &bleveIndex{})
+}
@@ -0,0 +1,66 @@
+// +build bleve
+
+package bleve
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tinode/chat/server/search"
+	t "github.com/tinode/chat/server/store/types"
+)
+
+func TestSearchScopes(tt *testing.T) {
+	bi := &bleveIndex{}
+	if err := bi.Init(`{"index_path": "` + tt.TempDir() + `/index"}`); err != nil {
+		tt.Fatal(err)
+	}
+	defer bi.Close()
+
+	ts := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for seq := 1; seq <= 5; seq++ {
+		ts = ts.Add(time.Minute)
+		if err := bi.Add("grpOne", seq, ts, "hello world"); err != nil {
+			tt.Fatal(err)
+		}
+		if err := bi.Add("grpTwo", seq, ts, "hello there"); err != nil {
+			tt.Fatal(err)
+		}
+	}
+
+	inputs := []struct {
+		query  string
+		scopes []t.SearchScope
+		offset int
+		limit  int
+	}{
+		{"hello world", []t.SearchScope{{Topic: "grpOne"}}, 0, 10},
+		{"hello", []t.SearchScope{{Topic: "grpTwo", Since: 2, Before: 4}}, 0, 10},
+		{"hello world", []t.SearchScope{{Topic: "grpTwo"}}, 0, 10},
+		{"hello", []t.SearchScope{{Topic: "grpOne", Before: 3}, {Topic: "grpTwo", Since: 5}}, 0, 10},
+		{"hello", []t.SearchScope{{Topic: "grpOne"}}, 2, 2},
+		{"hello", nil, 0, 10},
+	}
+	hit := func(topic string, seqId int) search.Hit {
+		return search.Hit{Topic: topic, SeqId: seqId}
+	}
+	expect := [][]search.Hit{
+		{hit("grpOne", 5), hit("grpOne", 4), hit("grpOne", 3), hit("grpOne", 2), hit("grpOne", 1)},
+		{hit("grpTwo", 3), hit("grpTwo", 2)},
+		{},
+		{hit("grpTwo", 5), hit("grpOne", 2), hit("grpOne", 1)},
+		{hit("grpOne", 3), hit("grpOne", 2)},
+		nil,
+	}
+
+	for i, in := range inputs {
+		hits, err := bi.Search(in.query, in.scopes, in.offset, in.limit)
+		if err != nil {
+			tt.Fatalf("%d: unexpected error %v", i, err)
+		}
+		if !reflect.DeepEqual(hits, expect[i]) {
+			tt.Errorf("%d: expected %v, got %v", i, expect[i], hits)
+		}
+	}
+}
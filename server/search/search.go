@@ -0,0 +1,141 @@
+// Package search defines an interface which must be implemented by embedded full-text indexes of
+// messages. An embedded index is used for message search when the database adapter has no native
+// full-text search.
+package search
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	t "github.com/tinode/chat/server/store/types"
+)
+
+// Hit identifies a message which matches a search query.
+type Hit struct {
+	Topic string
+	SeqId int
+}
+
+// Index is an embedded full-text index of plain text of messages.
+type Index interface {
+	// Init opens or creates the index.
+	Init(jsonconf string) error
+
+	// Add indexes text of the message. Earlier text of the same message is replaced.
+	Add(topic string, seqId int, ts time.Time, text string) error
+
+	// Delete removes messages with IDs in the ranges from the index. If ranges is nil,
+	// all messages of the topic are removed.
+	Delete(topic string, ranges []t.Range) error
+
+	// Search returns up to limit messages within the scopes which contain all words of the query,
+	// newest first, skipping the first offset messages.
+	Search(query string, scopes []t.SearchScope, offset, limit int) ([]Hit, error)
+
+	// Close flushes and closes the index.
+	Close() error
+}
+
+// Span is a position of a matched search term in text, in characters.
+type Span struct {
+	At  int
+	Len int
+}
+
+// Terms splits the query into distinct lowercase words. Punctuation is dropped.
+func Terms(query string) []string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var terms []string
+	seen := make(map[string]bool, len(words))
+	for _, w := range words {
+		if !seen[w] {
+			seen[w] = true
+			terms = append(terms, w)
+		}
+	}
+	return terms
+}
+
+// Highlight finds words of the query in the text, ignoring case. It returns a fragment of the text
+// no longer than maxLen characters around the first match and positions of the matches within the
+// fragment. If maxLen is zero, the whole text is returned.
+func Highlight(text, query string, maxLen int) (string, []Span) {
+	src := []rune(text)
+	lower := make([]rune, len(src))
+	for i, r := range src {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	var spans []Span
+	for _, term := range Terms(query) {
+		tr := []rune(term)
+		for i := 0; i+len(tr) <= len(lower); i++ {
+			if equalRunes(lower[i:i+len(tr)], tr) {
+				spans = append(spans, Span{At: i, Len: len(tr)})
+			}
+		}
+	}
+	spans = mergeSpans(spans)
+
+	start, end := 0, len(src)
+	if maxLen > 0 && len(src) > maxLen {
+		if len(spans) > 0 {
+			// Show some context before the first match.
+			start = spans[0].At - maxLen/4
+			if start < 0 {
+				start = 0
+			}
+		}
+		end = start + maxLen
+		if end > len(src) {
+			end = len(src)
+			start = end - maxLen
+		}
+	}
+
+	var result []Span
+	for _, sp := range spans {
+		if sp.At >= start && sp.At+sp.Len <= end {
+			result = append(result, Span{At: sp.At - start, Len: sp.Len})
+		}
+	}
+	return string(src[start:end]), result
+}
+
+func equalRunes(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Sort spans and merge the overlapping ones.
+func mergeSpans(spans []Span) []Span {
+	if len(spans) < 2 {
+		return spans
+	}
+
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].At < spans[j].At
+	})
+
+	merged := spans[:1]
+	for _, sp := range spans[1:] {
+		last := &merged[len(merged)-1]
+		if sp.At <= last.At+last.Len {
+			if end := sp.At + sp.Len; end > last.At+last.Len {
+				last.Len = end - last.At
+			}
+		} else {
+			merged = append(merged, sp)
+		}
+	}
+	return merged
+}
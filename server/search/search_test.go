@@ -0,0 +1,47 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTerms(t *testing.T) {
+	got := Terms(`Hello, "world" +hello -Мир`)
+	expect := []string{"hello", "world", "мир"}
+	if !reflect.DeepEqual(got, expect) {
+		t.Errorf("Terms: expected %v, got %v", expect, got)
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	inputs := []struct {
+		text   string
+		query  string
+		maxLen int
+	}{
+		{"Hello world, hello again", "hello", 0},
+		{"Привет, МИР", "мир привет", 0},
+		{"abcabc", "abc bca", 0},
+		{"0123456789 needle 0123456789", "needle", 12},
+		{"needle 0123456789", "needle", 10},
+		{"nothing here", "needle", 0},
+	}
+	expect := []struct {
+		text  string
+		spans []Span
+	}{
+		{"Hello world, hello again", []Span{{0, 5}, {13, 5}}},
+		{"Привет, МИР", []Span{{0, 6}, {8, 3}}},
+		{"abcabc", []Span{{0, 6}}},
+		{"89 needle 01", []Span{{3, 6}}},
+		{"needle 012", []Span{{0, 6}}},
+		{"nothing here", nil},
+	}
+
+	for i, in := range inputs {
+		text, spans := Highlight(in.text, in.query, in.maxLen)
+		if text != expect[i].text || !reflect.DeepEqual(spans, expect[i].spans) {
+			t.Errorf("%d: expected %q %v, got %q %v", i, expect[i].text, expect[i].spans, text, spans)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tinode/chat/server/store/types"
+)
+
+func TestSearchScopes(t *testing.T) {
+	subs := []types.Subscription{
+		{Topic: "grpReadable", ModeWant: types.ModeCPublic, ModeGiven: types.ModeCPublic, ReadSeqId: 5},
+		// Given R but does not want it.
+		{Topic: "grpUnwanted", ModeWant: types.ModeWrite, ModeGiven: types.ModeCPublic, ReadSeqId: 5},
+		// Wants R but is not given it.
+		{Topic: "grpBanned", ModeWant: types.ModeCPublic, ModeGiven: types.ModeNone, ReadSeqId: 5},
+		{Topic: "p2pAbcDef", ModeWant: types.ModeCP2P, ModeGiven: types.ModeCP2P, ReadSeqId: 7},
+	}
+	subs[3].SetWith("usrAbc")
+
+	inputs := []bool{false, true}
+	expect := [][]types.SearchScope{
+		{{Topic: "grpReadable"}, {Topic: "p2pAbcDef"}},
+		{{Topic: "grpReadable", Before: 6}, {Topic: "p2pAbcDef", Before: 8}},
+	}
+	expectNames := map[string]string{"grpReadable": "grpReadable", "p2pAbcDef": "usrAbc"}
+
+	for i, read := range inputs {
+		scopes, names := searchScopes(subs, read)
+		if !reflect.DeepEqual(scopes, expect[i]) {
+			t.Errorf("%d: expected scopes %v, got %v", i, expect[i], scopes)
+		}
+		if !reflect.DeepEqual(names, expectNames) {
+			t.Errorf("%d: expected names %v, got %v", i, expectNames, names)
+		}
+	}
+}
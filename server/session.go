@@ -12,7 +12,6 @@ package main
 import (
 	"container/list"
 	"encoding/json"
-	"errors"
 	"log"
 	"net/http"
 	"strings"
@@ -352,6 +351,7 @@ func (s *Session) dispatch(msg *ClientComMessage) {
 		uaRefresh = true
 	case msg.Signal != nil:
 		handler = s.signal
+		msg.id = msg.Signal.Id
 		msg.topic = msg.Signal.Topic
 		uaRefresh = true
 	default:
@@ -483,7 +483,9 @@ func (s *Session) publish(msg *ClientComMessage) {
 		From:      msg.from,
 		Timestamp: msg.timestamp,
 		Head:      msg.Pub.Head,
-		Content:   msg.Pub.Content},
+		Content:   msg.Pub.Content,
+		ReplyTo:   msg.Pub.ReplyTo,
+		replace:   msg.Pub.Replace},
 		// Unroutable values.
 		rcptto:    expanded,
 		sess:      s,
@@ -611,6 +613,13 @@ func (s *Session) acc(msg *ClientComMessage) {
 	}
 }
 
+// Lifetime of a password recovery request and of the token issued for it.
+const resetRequestLifetime = time.Hour * 24
+
+// Number of failed attempts to respond with the code of a password recovery request before the request
+// is locked out.
+const maxResetCodeRetries = 4
+
 // Authenticate
 func (s *Session) login(msg *ClientComMessage) {
 	// msg.from is ignored here
@@ -620,45 +629,8 @@ func (s *Session) login(msg *ClientComMessage) {
 		return
 	}
 
-	// check forgot
 	if msg.Login.Scheme == "forgot" {
-		var credMethod, credValue, resp string
-		if msg.Login.Cred == nil {
-			s.queueOut(decodeStoreError(errors.New("cred is nil"), msg.Login.Id, "", msg.timestamp, nil))
-			return
-		}
-		credMethod = msg.Login.Cred[0].Method
-		credValue = msg.Login.Cred[0].Value
-		resp = msg.Login.Cred[0].Response
-
-		uid, err := store.Users.GetByCred(credMethod, credValue)
-		if err != nil {
-			s.queueOut(decodeStoreError(err, msg.Login.Id, "", msg.timestamp, nil))
-			return
-		}
-
-		vld := store.GetValidator(credMethod)
-		_, err = vld.Check(uid, resp)
-		if err != nil {
-			s.queueOut(decodeStoreError(err, msg.Login.Id, "", msg.timestamp, nil))
-			return
-		}
-		err = store.Forgot.ConfirmForgot(credValue)
-		if err != nil {
-			s.queueOut(decodeStoreError(err, msg.Login.Id, "", msg.timestamp, nil))
-			return
-		}
-
-		forgot, err := store.Forgot.GetForgot(credValue)
-		if err != nil {
-			s.queueOut(decodeStoreError(err, msg.Login.Id, "", msg.timestamp, nil))
-			return
-		}
-		s.queueOut(NoErrParams(
-			msg.Login.Id,
-			"",
-			map[string]interface{}{"tempToken": forgot.Token, "userid": uid.UserId()},
-			msg.timestamp))
+		s.queueOut(s.authForgot(msg))
 		return
 	}
 
@@ -729,17 +701,62 @@ func (s *Session) authSecretReset(params []byte) error {
 		return types.ErrNotFound
 	}
 
-	token, _, err := store.GetLogicalAuthHandler("token").GenSecret(&auth.Rec{
+	token, expires, err := store.GetLogicalAuthHandler("token").GenSecret(&auth.Rec{
 		Uid:       uid,
 		AuthLevel: auth.LevelNone,
-		Lifetime:  time.Hour * 24,
+		Lifetime:  resetRequestLifetime,
 		Features:  auth.FeatureNoLogin})
 
 	if err != nil {
 		return err
 	}
 
-	return validator.ResetSecret(credValue, authScheme, s.lang, token)
+	// The token is accepted for resetting the secret only while the request is pending.
+	if err = store.Forgot.Save(&types.Forgot{
+		UserId:    uid.String(),
+		Method:    credMethod,
+		Value:     credValue,
+		Token:     token,
+		ExpiresAt: expires,
+	}); err != nil {
+		return err
+	}
+
+	return validator.ResetSecret(credValue, authScheme, s.lang, s.remoteAddr, token)
+}
+
+// authForgot handles the second step of the password recovery: the user responds with the code
+// received through the credential and gets the temporary token for resetting the secret.
+func (s *Session) authForgot(msg *ClientComMessage) *ServerComMessage {
+	if len(msg.Login.Cred) == 0 {
+		return ErrMalformed(msg.id, "", msg.timestamp)
+	}
+	cred := msg.Login.Cred[0]
+
+	validator := store.GetValidator(cred.Method)
+	if validator == nil {
+		return ErrMalformed(msg.id, "", msg.timestamp)
+	}
+	uid, err := store.Users.GetByCred(cred.Method, cred.Value)
+	if err == nil && uid.IsZero() {
+		err = types.ErrNotFound
+	}
+	if err != nil {
+		return decodeStoreError(err, msg.id, "", msg.timestamp, nil)
+	}
+
+	forgot, err := store.Forgot.GetByCred(cred.Method, cred.Value)
+	if err != nil {
+		log.Println("s.login: no pending recovery request", cred.Method, err, s.sid)
+		return decodeStoreError(err, msg.id, "", msg.timestamp, nil)
+	}
+
+	if err = store.Forgot.CheckCode(forgot, cred.Response, maxResetCodeRetries); err != nil {
+		return decodeStoreError(err, msg.id, "", msg.timestamp, nil)
+	}
+
+	return NoErrParams(msg.id, "",
+		map[string]interface{}{"tempToken": forgot.Token, "userid": uid.UserId()}, msg.timestamp)
 }
 
 // onLogin performs steps after successful authentication.
@@ -890,7 +907,8 @@ func (s *Session) get(msg *ClientComMessage) {
 		if err := globals.cluster.routeToTopic(msg, expanded, s); err != nil {
 			s.queueOut(ErrClusterUnreachable(msg.id, msg.topic, msg.timestamp))
 		}
-	} else if meta.what&(constMsgMetaData|constMsgMetaDel|constMsgMetaTags) != 0 {
+	} else if meta.what&(constMsgMetaData|constMsgMetaDel|constMsgMetaTags|constMsgMetaIce|constMsgMetaCall|
+		constMsgMetaBlocked|constMsgMetaPrivacy|constMsgMetaSearch) != 0 {
 		log.Println("s.get: subscribe first to get=", msg.Get.What)
 		s.queueOut(ErrPermissionDenied(msg.id, msg.topic, msg.timestamp))
 	} else {
@@ -921,6 +939,15 @@ func (s *Session) set(msg *ClientComMessage) {
 	if msg.Set.Tags != nil {
 		meta.what |= constMsgMetaTags
 	}
+	if msg.Set.Block != nil {
+		meta.what |= constMsgMetaBlocked
+	}
+	if msg.Set.Privacy != nil {
+		meta.what |= constMsgMetaPrivacy
+	}
+	if msg.Set.Contact != nil {
+		meta.what |= constMsgMetaContact
+	}
 
 	if meta.what == 0 {
 		s.queueOut(ErrMalformed(msg.id, msg.topic, msg.timestamp))
@@ -932,8 +959,8 @@ func (s *Session) set(msg *ClientComMessage) {
 		if err := globals.cluster.routeToTopic(msg, expanded, s); err != nil {
 			s.queueOut(ErrClusterUnreachable(msg.id, msg.topic, msg.timestamp))
 		}
-	} else if meta.what&constMsgMetaTags != 0 {
-		log.Println("s.set: can Set tags for subscribed topics only")
+	} else if meta.what&(constMsgMetaTags|constMsgMetaBlocked|constMsgMetaPrivacy|constMsgMetaContact) != 0 {
+		log.Println("s.set: can Set tags, block list, privacy and contacts for subscribed topics only")
 		s.queueOut(ErrPermissionDenied(msg.id, msg.topic, msg.timestamp))
 	} else {
 		// Some minor updates are possible without the subscription.
@@ -1023,6 +1050,10 @@ func (s *Session) note(msg *ClientComMessage) {
 		if msg.Note.ContactId == "" {
 			return
 		}
+	case "react", "unreact":
+		if msg.Note.SeqId <= 0 || !validReaction(msg.Note.Emoji) {
+			return
+		}
 	default:
 		return
 	}
@@ -1036,6 +1067,7 @@ func (s *Session) note(msg *ClientComMessage) {
 			SeqId:        msg.Note.SeqId,
 			ContactId:    msg.Note.ContactId,
 			ContactState: msg.Note.ContactState,
+			Emoji:        msg.Note.Emoji,
 		}, rcptto: expanded, timestamp: msg.timestamp, skipSid: s.sid}
 	} else if globals.cluster.isRemoteTopic(expanded) {
 		// The topic is handled by a remote node. Forward message to it.
@@ -1057,8 +1089,9 @@ func (s *Session) contact(msg *ClientComMessage) {
 	}
 
 	if sub := s.getSub(expanded); sub != nil {
-		// Pings can be sent to subscribed topics only
-		sub.broadcast <- &ServerComMessage{
+		// Contact requests can be sent to subscribed topics only. They are processed by the social
+		// handler and not by the topic.
+		s.submitSocial(msg, &ServerComMessage{
 			id:   msg.id,
 			from: msg.from,
 			sess: s,
@@ -1067,7 +1100,10 @@ func (s *Session) contact(msg *ClientComMessage) {
 				Sender:    msg.Contact.Sender,
 				Receiver:  msg.Contact.Receiver,
 				ContactId: msg.Contact.ContactId,
-			}, rcptto: expanded, timestamp: msg.timestamp, skipSid: s.sid}
+				Message:   msg.Contact.Message,
+				Source:    msg.Contact.Source,
+				answer:    msg.Contact.Answer,
+			}, rcptto: expanded, timestamp: msg.timestamp, skipSid: s.sid})
 
 	} else if globals.cluster.isRemoteTopic(expanded) {
 		// The topic is handled by a remote node. Forward message to it.
@@ -1088,9 +1124,19 @@ func (s *Session) signal(msg *ClientComMessage) {
 		return
 	}
 
+	if int64(len(msg.Signal.Payload)) > globals.maxMessageSize {
+		s.queueOut(ErrTooLarge(msg.id, msg.topic, msg.timestamp))
+		return
+	}
+
+	if msg.Signal.User != "" {
+		s.signalPeer(msg)
+		return
+	}
+
 	if sub := s.getSub(expanded); sub != nil {
-		// Pings can be sent to subscribed topics only
-		sub.broadcast <- &ServerComMessage{
+		// Signals can be sent to subscribed topics only. They are processed by the social handler.
+		s.submitSocial(msg, &ServerComMessage{
 			id:   msg.id,
 			from: msg.from,
 			sess: s,
@@ -1098,7 +1144,8 @@ func (s *Session) signal(msg *ClientComMessage) {
 				Target:  msg.Signal.Target,
 				Command: msg.Signal.Command,
 				Room:    msg.Signal.Room,
-			}, rcptto: expanded, timestamp: msg.timestamp, skipSid: s.sid}
+				Payload: msg.Signal.Payload,
+			}, rcptto: expanded, timestamp: msg.timestamp, skipSid: s.sid})
 
 	} else if globals.cluster.isRemoteTopic(expanded) {
 		// The topic is handled by a remote node. Forward message to it.
@@ -1107,6 +1154,63 @@ func (s *Session) signal(msg *ClientComMessage) {
 
 }
 
+// submitSocial passes {contact} or {signal} to the social handler. The request is rejected if the
+// handler is overloaded.
+func (s *Session) submitSocial(msg *ClientComMessage, req *ServerComMessage) {
+	if !globals.hub.social.submit(req, msg.topic) {
+		log.Println("s.submitSocial: queue is full", s.sid)
+		s.queueOut(ErrUnknown(msg.id, msg.topic, msg.timestamp))
+	}
+}
+
+// signalPeer delivers {signal} to all sessions of a single peer user, such as SDP offers or ICE candidates.
+// The signal is routed to the 'me' topic of the peer, possibly on another cluster node. No push
+// notifications are sent.
+func (s *Session) signalPeer(msg *ClientComMessage) {
+	peer := types.ParseUserId(msg.Signal.User)
+	if peer.IsZero() || peer.UserId() == msg.from {
+		s.queueOut(ErrMalformed(msg.id, msg.topic, msg.timestamp))
+		return
+	}
+	// Signals forwarded by another cluster node have been checked there already.
+	if s.proto != CLUSTER && !canSignalPeer(types.ParseUserId(msg.from), peer, msg.Signal.Room) {
+		s.queueOut(ErrPermissionDenied(msg.id, msg.topic, msg.timestamp))
+		return
+	}
+
+	routeTo := peer.UserId()
+	if globals.cluster.isRemoteTopic(routeTo) {
+		// The 'me' topic of the peer is handled by a remote node. Forward message to it.
+		if err := globals.cluster.routeToTopic(msg, routeTo, s); err != nil {
+			log.Println("s.signalPeer:", err, s.sid)
+			s.queueOut(ErrClusterUnreachable(msg.id, msg.topic, msg.timestamp))
+		}
+		return
+	}
+
+	// Delivered to online sessions only. Signals to offline users are silently dropped.
+	globals.hub.route <- &ServerComMessage{
+		Signal: &MsgServerSignal{
+			Target:  msg.Signal.Target,
+			Command: msg.Signal.Command,
+			Room:    msg.Signal.Room,
+			From:    msg.from,
+			User:    routeTo,
+			Payload: msg.Signal.Payload,
+		}, rcptto: routeTo, from: msg.from, timestamp: msg.timestamp}
+}
+
+// canSignalPeer checks if the user may send signals to the peer. Signals of a call are permitted between
+// the parties of the call only. The parties are checked once when the call is set up. Signals outside of
+// a call require a P2P topic in common.
+func canSignalPeer(uid, peer types.Uid, room string) bool {
+	if room != "" {
+		return globals.callRegistry.sharesCall(room, uid, peer)
+	}
+	sub, err := store.Subs.Get(uid.P2PName(peer), uid)
+	return err == nil && sub != nil
+}
+
 // expandTopicName expands session specific topic name to global name
 // Returns
 //   topic: session-specific topic name the message recipient should see
@@ -0,0 +1,125 @@
+/******************************************************************************
+ *
+ *  Description:
+ *
+ *  Social handler: processes {contact} and {signal} sent by users to the 'me'
+ *  topic outside of the topic's goroutine. Requests of one user are handled
+ *  sequentially, requests of different users are handled concurrently.
+ *
+ *****************************************************************************/
+
+package main
+
+import (
+	"log"
+
+	"github.com/tinode/chat/server/push"
+	"github.com/tinode/chat/server/store/types"
+)
+
+const (
+	// Number of goroutines processing social requests.
+	socialWorkers = 16
+	// Number of requests each worker can queue.
+	socialQueueLen = 256
+)
+
+// socialReq is a {contact} or {signal} from a session.
+type socialReq struct {
+	msg *ServerComMessage
+	// Name of the topic as sent by the user, i.e. "me".
+	original string
+}
+
+// socialHandler dispatches social requests to a fixed pool of workers. Requests are assigned to
+// workers by the sender's user ID so the replies to each session are delivered in order.
+type socialHandler struct {
+	queues []chan *socialReq
+	// Closed to stop the workers.
+	done chan struct{}
+}
+
+func newSocialHandler() *socialHandler {
+	sh := &socialHandler{
+		queues: make([]chan *socialReq, socialWorkers),
+		done:   make(chan struct{}),
+	}
+	for i := range sh.queues {
+		sh.queues[i] = make(chan *socialReq, socialQueueLen)
+		go sh.run(sh.queues[i])
+	}
+	return sh
+}
+
+// submit queues the request for processing. Returns false if the queue is full.
+func (sh *socialHandler) submit(msg *ServerComMessage, original string) bool {
+	uid := types.ParseUserId(msg.from)
+	select {
+	case sh.queues[uint64(uid)%uint64(len(sh.queues))] <- &socialReq{msg: msg, original: original}:
+		return true
+	default:
+		return false
+	}
+}
+
+// shutdown stops the workers. Queued requests are discarded.
+func (sh *socialHandler) shutdown() {
+	close(sh.done)
+}
+
+func (sh *socialHandler) run(queue <-chan *socialReq) {
+	for {
+		select {
+		case req := <-queue:
+			sh.handle(req)
+		case <-sh.done:
+			return
+		}
+	}
+}
+
+// handle processes one request, sends push notifications and forwards the message to the user's
+// other sessions if needed.
+func (sh *socialHandler) handle(req *socialReq) {
+	msg := req.msg
+	asUid := types.ParseUserId(msg.from)
+
+	var pushRcpt *pushReceipt
+	var forward bool
+	if msg.Contact != nil {
+		user := types.ParseUserId(msg.Contact.Sender)
+		contact := types.ParseUserId(msg.Contact.Receiver)
+		if user != asUid {
+			msg.sess.queueOut(ErrPermissionDenied(msg.id, req.original, msg.timestamp))
+			return
+		}
+
+		switch msg.Contact.What {
+		case "add":
+			pushRcpt, forward = replyContactAdd(msg, req.original, user, contact)
+		case "reject":
+			pushRcpt, forward = replyContactRespond(msg, req.original, user, contact, false)
+		case "agree":
+			pushRcpt, forward = replyContactRespond(msg, req.original, user, contact, true)
+		default:
+			msg.sess.queueOut(ErrMalformed(msg.id, req.original, msg.timestamp))
+			return
+		}
+		if forward {
+			msg.sess.queueOut(NoErr(msg.id, req.original, msg.timestamp))
+		}
+	} else if msg.Signal != nil {
+		pushRcpt, forward = replySignal(msg, req.original, asUid)
+	} else {
+		log.Println("social: unexpected message", msg.from)
+		return
+	}
+
+	if pushRcpt != nil {
+		push.Push(pushRcpt.rcpt)
+	}
+	if forward {
+		// The 'me' topic delivers the message to the user's other sessions.
+		globals.hub.route <- msg
+	}
+}
@@ -0,0 +1,118 @@
+package store
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tinode/chat/server/db"
+	"github.com/tinode/chat/server/search"
+	"github.com/tinode/chat/server/store/types"
+)
+
+// Adapter which knows only about messages. Calls to other methods panic.
+type searchTestAdapter struct {
+	adapter.Adapter
+	messages map[int]*types.Message
+	deleted  []types.DelMessage
+}
+
+func (a *searchTestAdapter) MessageGet(topic string, seqId int) (*types.Message, error) {
+	return a.messages[seqId], nil
+}
+
+func (a *searchTestAdapter) MessageGetDeleted(topic string, forUser types.Uid,
+	opts *types.QueryOpt) ([]types.DelMessage, error) {
+	return a.deleted, nil
+}
+
+// Index which returns the same hits for any query and records requested offsets.
+type searchTestIndex struct {
+	search.Index
+	hits    []search.Hit
+	offsets []int
+}
+
+func (si *searchTestIndex) Search(query string, scopes []types.SearchScope, offset, limit int) ([]search.Hit, error) {
+	si.offsets = append(si.offsets, offset)
+	if offset >= len(si.hits) {
+		return nil, nil
+	}
+	end := offset + limit
+	if end > len(si.hits) {
+		end = len(si.hits)
+	}
+	return si.hits[offset:end], nil
+}
+
+func TestSearchFiltersDeleted(t *testing.T) {
+	const topic = "grpTest"
+	messages := make(map[int]*types.Message)
+	var hits []search.Hit
+	for seq := 10; seq > 0; seq-- {
+		messages[seq] = &types.Message{Topic: topic, SeqId: seq}
+		hits = append(hits, search.Hit{Topic: topic, SeqId: seq})
+	}
+	// Deleted for everyone.
+	messages[10].DelId = 1
+	// Recalled.
+	messages[9].Recalled = true
+	// Missing from the database.
+	delete(messages, 8)
+
+	fakeAdp := &searchTestAdapter{
+		messages: messages,
+		// Deleted for the user.
+		deleted: []types.DelMessage{{SeqIdRanges: []types.Range{{Low: 5, Hi: 8}}}},
+	}
+	fakeIndex := &searchTestIndex{hits: hits}
+
+	savedAdp, savedIndex := adp, searchIndex
+	adp, searchIndex = fakeAdp, fakeIndex
+	defer func() {
+		adp, searchIndex = savedAdp, savedIndex
+	}()
+
+	inputs := []struct {
+		limit int
+	}{
+		{2}, {3}, {10},
+	}
+	expect := []struct {
+		seqIds  []int
+		offsets []int
+	}{
+		// First page [10..7] has no messages left, second page [6..3] gives 4 and 3.
+		{[]int{4, 3}, []int{0, 4}},
+		// First page [10..5] has no messages left, second page [4..1] gives enough.
+		{[]int{4, 3, 2}, []int{0, 6}},
+		// The index has fewer hits than requested.
+		{[]int{4, 3, 2, 1}, []int{0}},
+	}
+
+	for i, in := range inputs {
+		fakeIndex.offsets = nil
+		msgs, err := Messages.Search(types.ZeroUid, "query", []types.SearchScope{{Topic: topic}}, in.limit)
+		if err != nil {
+			t.Fatalf("%d: unexpected error %v", i, err)
+		}
+		var seqIds []int
+		for _, msg := range msgs {
+			seqIds = append(seqIds, msg.SeqId)
+		}
+		if !reflect.DeepEqual(seqIds, expect[i].seqIds) {
+			t.Errorf("%d: expected messages %v, got %v", i, expect[i].seqIds, seqIds)
+		}
+		if !reflect.DeepEqual(fakeIndex.offsets, expect[i].offsets) {
+			t.Errorf("%d: expected offsets %v, got %v", i, expect[i].offsets, fakeIndex.offsets)
+		}
+	}
+}
+
+func TestInRanges(t *testing.T) {
+	ranges := []types.Range{{Low: 1}, {Low: 5, Hi: 8}}
+	for seq, expect := range map[int]bool{1: true, 2: false, 4: false, 5: true, 7: true, 8: false} {
+		if got := inRanges(seq, ranges); got != expect {
+			t.Errorf("inRanges(%d): expected %v, got %v", seq, expect, got)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package store
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"sort"
@@ -9,13 +10,16 @@ import (
 
 	"github.com/tinode/chat/server/auth"
 	"github.com/tinode/chat/server/db"
+	"github.com/tinode/chat/server/drafty"
 	"github.com/tinode/chat/server/media"
+	"github.com/tinode/chat/server/search"
 	"github.com/tinode/chat/server/store/types"
 	"github.com/tinode/chat/server/validate"
 )
 
 var adp adapter.Adapter
 var mediaHandler media.Handler
+var searchIndex search.Index
 
 // Unique ID generator
 var uGen types.UidGenerator
@@ -71,6 +75,10 @@ func Open(workerId int, jsonconf string) error {
 
 // Close terminates connection to persistent storage.
 func Close() error {
+	if searchIndex != nil {
+		searchIndex.Close()
+	}
+
 	if adp.IsOpen() {
 		return adp.Close()
 	}
@@ -150,21 +158,80 @@ func EncodeUid(id int64) types.Uid {
 	return uGen.EncodeInt64(id)
 }
 
+// ForgotMapper is a struct to hold methods for persistence mapping of password recovery requests.
 type ForgotMapper struct{}
 
+// Forgot is an instance of ForgotMapper to map methods to.
 var Forgot ForgotMapper
 
-func (ForgotMapper) SaveForgot(forgot *types.Forgot) error {
+// Save saves a new password recovery request.
+func (ForgotMapper) Save(forgot *types.Forgot) error {
 	forgot.InitTimes()
 	return adp.ForgotAdd(forgot)
 }
 
-func (ForgotMapper) ConfirmForgot(tel string) error {
-	return adp.ForgotUpd(tel)
+// GetByCred returns the pending password recovery request for the given credential.
+// Returns ErrNotFound if there is no such request or it's already used, ErrExpired if it has expired.
+func (ForgotMapper) GetByCred(method, value string) (*types.Forgot, error) {
+	forgot, err := adp.ForgotGet(method, value)
+	if err != nil {
+		return nil, err
+	}
+	if forgot == nil || forgot.Done {
+		return nil, types.ErrNotFound
+	}
+	if forgot.ExpiresAt.Before(types.TimeNow()) {
+		return nil, types.ErrExpired
+	}
+	return forgot, nil
+}
+
+// SetCode saves the one-time code which the user must respond with to get the token of the request.
+func (ForgotMapper) SetCode(id, code string) error {
+	return adp.ForgotSetCode(id, code)
 }
 
-func (ForgotMapper) GetForgot(tel string) (*types.Forgot, error) {
-	return adp.ForgotGet(tel)
+// CheckCode compares the response with the code of the pending password recovery request. Failed
+// attempts are counted, after maxRetries failures the code is no longer accepted.
+func (ForgotMapper) CheckCode(forgot *types.Forgot, resp string, maxRetries int) error {
+	if forgot.Code == "" {
+		return types.ErrNotFound
+	}
+	if forgot.Retries >= maxRetries {
+		return types.ErrPolicy
+	}
+	if resp == "" {
+		return types.ErrCredentials
+	}
+	if forgot.Code != resp {
+		adp.ForgotFail(forgot.Id)
+		return types.ErrCredentials
+	}
+	return nil
+}
+
+// Use finds the pending password recovery request of the user with the given token and marks it as used,
+// so the token cannot be used again. Returns ErrNotFound if there is no such request, ErrExpired if it has expired.
+func (ForgotMapper) Use(uid types.Uid, token []byte) (*types.Forgot, error) {
+	requests, err := adp.ForgotGetByUser(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range requests {
+		forgot := &requests[i]
+		if !bytes.Equal(forgot.Token, token) {
+			continue
+		}
+		if forgot.ExpiresAt.Before(types.TimeNow()) {
+			return nil, types.ErrExpired
+		}
+		if err := adp.ForgotUpd(forgot.Id); err != nil {
+			return nil, err
+		}
+		return forgot, nil
+	}
+	return nil, types.ErrNotFound
 }
 
 // UsersObjMapper is a users struct to hold methods for persistence mapping for the User object.
@@ -290,6 +357,11 @@ func (UsersObjMapper) UpdateTags(uid types.Uid, tags []string, reset bool) error
 	return adp.UserUpdateTags(uid, tags, reset)
 }
 
+// GetUnreadCount returns the total number of unread messages in all topics the user is subscribed to.
+func (UsersObjMapper) GetUnreadCount(uid types.Uid) (int, error) {
+	return adp.UserUnreadCount(uid)
+}
+
 // GetSubs loads a list of subscriptions for the given user. Does not load Public, does not load
 // deleted subscriptions.
 func (UsersObjMapper) GetSubs(id types.Uid, opts *types.QueryOpt) ([]types.Subscription, error) {
@@ -331,6 +403,12 @@ func (UsersObjMapper) SaveCred(cred *types.Credential) error {
 	return adp.CredAdd(cred)
 }
 
+// UpsertCred adds an unconfirmed credential or replaces the response of the pending one.
+func (UsersObjMapper) UpsertCred(cred *types.Credential) error {
+	cred.InitTimes()
+	return adp.CredUpsert(cred)
+}
+
 // ConfirmCred marks credential as confirmed.
 func (UsersObjMapper) ConfirmCred(id types.Uid, method string) error {
 	return adp.CredConfirm(id, method)
@@ -448,7 +526,13 @@ func (TopicsObjMapper) OwnerChange(topic string, newOwner, oldOwner types.Uid) e
 
 // Delete deletes topic, messages, attachments, and subscriptions.
 func (TopicsObjMapper) Delete(topic string, hard bool) error {
-	return adp.TopicDelete(topic, hard)
+	if err := adp.TopicDelete(topic, hard); err != nil {
+		return err
+	}
+	if hard {
+		unindexMessages(topic, nil)
+	}
+	return nil
 }
 
 // SubsObjMapper is A struct to hold methods for persistence mapping for the Subscription object.
@@ -526,6 +610,8 @@ func (MessagesObjMapper) Save(msg *types.Message, readBySender bool) error {
 		return err
 	}
 
+	indexMessage(msg.Topic, msg.SeqId, msg.CreatedAt, msg.Content)
+
 	// Mark message as read by the sender.
 	if readBySender {
 		// Ignore the error here. It's not a big deal if it fails.
@@ -559,6 +645,12 @@ func (MessagesObjMapper) DeleteList(topic string, delID int, forUser types.Uid,
 		return err
 	}
 
+	if toDel == nil {
+		unindexMessages(topic, nil)
+	} else if forUser.IsZero() {
+		unindexMessages(topic, ranges)
+	}
+
 	// TODO: move to adapter
 	if delID > 0 {
 		// Record ID of the delete transaction
@@ -578,11 +670,63 @@ func (MessagesObjMapper) DeleteList(topic string, delID int, forUser types.Uid,
 	return err
 }
 
+// Recall hard-deletes a single message for all subscribers and keeps a tombstone marking it
+// as recalled by the sender.
+func (MessagesObjMapper) Recall(topic string, delID, seqId int) error {
+	toDel := &types.DelMessage{
+		Topic:       topic,
+		DelId:       delID,
+		SeqIdRanges: []types.Range{{Low: seqId}}}
+	toDel.InitTimes()
+
+	if err := adp.MessageRecall(topic, toDel); err != nil {
+		return err
+	}
+	unindexMessages(topic, toDel.SeqIdRanges)
+
+	if err := adp.TopicUpdate(topic, map[string]interface{}{"DelId": delID}); err != nil {
+		return err
+	}
+	return adp.SubsUpdate(topic, types.ZeroUid, map[string]interface{}{"DelId": delID})
+}
+
+// GetReplyCounts returns the number of replies to each of the given messages, keyed by message seq ID.
+func (MessagesObjMapper) GetReplyCounts(topic string, seqIds []int) (map[int]int, error) {
+	return adp.MessageReplyCounts(topic, seqIds)
+}
+
 // GetAll returns multiple messages.
 func (MessagesObjMapper) GetAll(topic string, forUser types.Uid, opt *types.QueryOpt) ([]types.Message, error) {
 	return adp.MessageGetAll(topic, forUser, opt)
 }
 
+// Get returns the message with the given seq ID or nil if not found.
+func (MessagesObjMapper) Get(topic string, seqId int) (*types.Message, error) {
+	return adp.MessageGet(topic, seqId)
+}
+
+// Edit replaces content of the message and keeps the current content as a revision. The message is
+// updated in place.
+func (MessagesObjMapper) Edit(msg *types.Message, content interface{}) error {
+	rev := types.MessageRevision{Timestamp: msg.CreatedAt, Content: msg.Content}
+	if msg.EditedAt != nil {
+		rev.Timestamp = *msg.EditedAt
+	}
+
+	now := types.TimeNow()
+	if err := adp.MessageEdit(msg.Topic, msg.SeqId, content, &rev, now); err != nil {
+		return err
+	}
+
+	indexMessage(msg.Topic, msg.SeqId, msg.CreatedAt, content)
+
+	msg.Revisions = append(msg.Revisions, rev)
+	msg.Content = content
+	msg.EditedAt = &now
+	msg.UpdatedAt = now
+	return nil
+}
+
 // GetDeleted returns the ranges of deleted messages and the largest DelId reported in the list.
 func (MessagesObjMapper) GetDeleted(topic string, forUser types.Uid, opt *types.QueryOpt) ([]types.Range, int, error) {
 	dmsgs, err := adp.MessageGetDeleted(topic, forUser, opt)
@@ -606,34 +750,186 @@ func (MessagesObjMapper) GetDeleted(topic string, forUser types.Uid, opt *types.
 	return ranges, maxID, nil
 }
 
+// Number of search index hits requested per message to return: some hits are filtered out as deleted.
+const searchOverFetch = 2
+
+// Search returns up to limit messages within the scopes which match the full-text query, newest first.
+// Messages deleted for the user or for everyone are skipped. The embedded search index is used if
+// configured, otherwise the search is performed by the database adapter.
+func (MessagesObjMapper) Search(forUser types.Uid, query string, scopes []types.SearchScope,
+	limit int) ([]types.Message, error) {
+
+	if searchIndex == nil {
+		return adp.MessageSearch(forUser, query, scopes, limit)
+	}
+
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	// The index does not know about messages deleted for one user only. Filter them out and fetch
+	// more hits until there are enough messages or the index has no more.
+	pageSize := limit * searchOverFetch
+	deleted := make(map[string][]types.Range)
+	var msgs []types.Message
+	for offset := 0; len(msgs) < limit; offset += pageSize {
+		hits, err := searchIndex.Search(query, scopes, offset, pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hit := range hits {
+			ranges, ok := deleted[hit.Topic]
+			if !ok {
+				if ranges, _, err = Messages.GetDeleted(hit.Topic, forUser, nil); err != nil {
+					return nil, err
+				}
+				deleted[hit.Topic] = ranges
+			}
+			if inRanges(hit.SeqId, ranges) {
+				continue
+			}
+
+			msg, err := adp.MessageGet(hit.Topic, hit.SeqId)
+			if err != nil {
+				return nil, err
+			}
+			// The message could have been deleted or recalled after it was indexed.
+			if msg != nil && msg.DelId == 0 && !msg.Recalled {
+				msgs = append(msgs, *msg)
+				if len(msgs) == limit {
+					break
+				}
+			}
+		}
+
+		if len(hits) < pageSize {
+			break
+		}
+	}
+	return msgs, nil
+}
+
+// Add text of the message to the embedded search index, if any. Failure to index the message is not fatal.
+func indexMessage(topic string, seqId int, ts time.Time, content interface{}) {
+	if searchIndex == nil {
+		return
+	}
+	if txt, err := drafty.ToPlainText(content); err == nil && txt != "" {
+		searchIndex.Add(topic, seqId, ts, txt)
+	}
+}
+
+// Remove messages from the embedded search index, if any. Nil ranges remove all messages of the topic.
+func unindexMessages(topic string, ranges []types.Range) {
+	if searchIndex != nil {
+		searchIndex.Delete(topic, ranges)
+	}
+}
+
+// Check if seqId belongs to one of the ranges.
+func inRanges(seqId int, ranges []types.Range) bool {
+	for _, r := range ranges {
+		if seqId == r.Low || (seqId > r.Low && seqId < r.Hi) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReactionsObjMapper is a struct to hold methods for persistence mapping of emoji reactions to messages.
+type ReactionsObjMapper struct{}
+
+// Reactions is an instance of ReactionsObjMapper to map methods to.
+var Reactions ReactionsObjMapper
+
+// Add adds user's reaction to the message. Returns false if the user has already reacted with the emoji.
+// Returns ErrPolicy if the user already has maxPerUser different reactions to the message or if the emoji
+// is new to the message which already has maxPerMessage different emoji.
+func (ReactionsObjMapper) Add(topic string, seqId int, user types.Uid, emoji string,
+	maxPerUser, maxPerMessage int) (bool, error) {
+
+	return adp.ReactionAdd(&types.Reaction{
+		CreatedAt: types.TimeNow(),
+		Topic:     topic,
+		SeqId:     seqId,
+		User:      user.String(),
+		Emoji:     emoji}, maxPerUser, maxPerMessage)
+}
+
+// Delete removes user's reaction to the message. Returns false if there was no such reaction.
+func (ReactionsObjMapper) Delete(topic string, seqId int, user types.Uid, emoji string) (bool, error) {
+	return adp.ReactionDelete(topic, seqId, user, emoji)
+}
+
+// GetCounts returns the number of reactions with each emoji to the given messages, keyed by message
+// seq ID. Emoji are listed in the order they were first used.
+func (ReactionsObjMapper) GetCounts(topic string, forUser types.Uid, seqIds []int) (map[int][]types.ReactionCount, error) {
+	reactions, err := adp.ReactionGetAll(topic, seqIds)
+	if err != nil {
+		return nil, err
+	}
+
+	user := forUser.String()
+	counts := make(map[int][]types.ReactionCount)
+	for i := range reactions {
+		r := &reactions[i]
+		list := counts[r.SeqId]
+		j := 0
+		for j < len(list) && list[j].Emoji != r.Emoji {
+			j++
+		}
+		if j == len(list) {
+			list = append(list, types.ReactionCount{Emoji: r.Emoji})
+		}
+		list[j].Count++
+		if r.User == user {
+			list[j].Mine = true
+		}
+		counts[r.SeqId] = list
+	}
+	return counts, nil
+}
+
 type ContactMessagesObjMapper struct{}
 
 // Messages is an instance of ContactMessagesObjMapper to map methods to.
 var ContMsg ContactMessagesObjMapper
 
-// Save two message, because query convenient
-func (ContactMessagesObjMapper) Save(user types.Uid, target types.Uid) (string, error) {
-	contactMsg := types.ContactMessage{
-		User:    user.String(),
-		Contact: target.String(),
-		State:   int(types.Add),
-	}
-	contactMsg.InitTimes()
-	_, err := adp.ContactMessageSave(&contactMsg)
-	if err != nil {
-		return "", err
+// Save saves the request from user to target twice, once for each party, because it's convenient to query.
+// The optional greeting and source are stored in both copies. Returns ID of the receiver's copy.
+func (ContactMessagesObjMapper) Save(user types.Uid, target types.Uid, greeting, source string,
+	expires *time.Time) (string, error) {
+
+	out := types.ContactMessage{
+		User:      user.String(),
+		Contact:   target.String(),
+		State:     int(types.Add),
+		Message:   greeting,
+		Source:    source,
+		ExpiresAt: expires,
 	}
+	out.InitTimes()
 
-	contactMsg.State = int(types.BeAddedUnread)
-	contactMsg.User = target.String()
-	contactMsg.Contact = user.String()
-	var contactId types.Uid
-	contactId, err = adp.ContactMessageSave(&contactMsg)
-	if err != nil {
+	in := out
+	in.State = int(types.BeAddedUnread)
+	in.User = target.String()
+	in.Contact = user.String()
+
+	if err := adp.ContactMessageCreate(&out, &in); err != nil {
 		return "", err
 	}
+	return in.Id, nil
+}
+
+// Get returns the request from user to contact or (nil, nil) if there is none.
+func (ContactMessagesObjMapper) Get(user types.Uid, contact types.Uid) (*types.ContactMessage, error) {
+	return adp.ContactMessageGet(user, contact)
+}
 
-	return string(contactId), nil
+// CountPending counts user's outgoing requests which are neither answered nor expired.
+func (ContactMessagesObjMapper) CountPending(user types.Uid) (int, error) {
+	return adp.ContactMessageCountPending(user, types.TimeNow())
 }
 
 func (ContactMessagesObjMapper) Delete(user types.Uid, contact types.Uid) error {
@@ -642,16 +938,30 @@ func (ContactMessagesObjMapper) Delete(user types.Uid, contact types.Uid) error
 	return err
 }
 
-func (ContactMessagesObjMapper) Update(user types.Uid, contact types.Uid, state types.ContactMessageState) error {
-	return adp.ContactMessageUpdate(user, contact, state)
+// Respond accepts or rejects the request from contact to user. If accepted, the users become contacts.
+func (ContactMessagesObjMapper) Respond(user types.Uid, contact types.Uid, agree bool) error {
+	return adp.ContactMessageRespond(user, contact, agree, types.TimeNow())
 }
 
-func (ContactMessagesObjMapper) UpdateById(id string, state types.ContactMessageState) error {
-	return adp.ContactMessageUpdateById(id, state)
+// UpdateById changes the state of the user's copy of the request with the given ID, i.e. marks it as read.
+func (ContactMessagesObjMapper) UpdateById(user types.Uid, id string, state types.ContactMessageState) error {
+	return adp.ContactMessageUpdateById(user, id, state)
 }
 
+// GetAll returns user's requests. Pending requests past their expiration time are reported as Expired.
 func (ContactMessagesObjMapper) GetAll(user types.Uid, opts *types.QueryOpt) ([]types.ContactMessage, error) {
-	return adp.ContactMessageForUser(user, opts)
+	msgs, err := adp.ContactMessageForUser(user, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	now := types.TimeNow()
+	for i := range msgs {
+		if msgs[i].IsExpired(now) {
+			msgs[i].State = int(types.Expired)
+		}
+	}
+	return msgs, nil
 }
 
 func (ContactMessagesObjMapper) IsAdded(user types.Uid, contact types.Uid) (bool, error) {
@@ -682,20 +992,106 @@ func (ContactObjMapper) Add(user types.Uid, contact types.Uid) error {
 	return err
 }
 
+// Delete removes the friendship between the two users on both sides.
 func (ContactObjMapper) Delete(user types.Uid, contact types.Uid) error {
-	err := adp.ContactDelete(user, contact)
-	err = adp.ContactDelete(contact, user)
-	return err
+	return adp.ContactDelete(user, contact)
 }
 
+// GetAll returns user's contacts. If opts.IfModifiedSince is set, only contacts added or deleted
+// after that time are returned. A deleted contact is skipped if the friendship was restored since.
 func (ContactObjMapper) GetAll(user types.Uid, opts *types.QueryOpt) ([]types.Contact, error) {
-	return adp.ContactForUser(user, opts)
+	contacts, err := adp.ContactForUser(user, opts)
+	if err != nil || opts == nil || opts.IfModifiedSince == nil {
+		return contacts, err
+	}
+
+	live := make(map[string]bool)
+	for i := range contacts {
+		if contacts[i].DeletedAt == nil {
+			live[contacts[i].Contact] = true
+		}
+	}
+	result := contacts[:0]
+	for i := range contacts {
+		if contacts[i].DeletedAt != nil && live[contacts[i].Contact] {
+			continue
+		}
+		result = append(result, contacts[i])
+	}
+	return result, nil
+}
+
+// Update updates user's metadata of the contact, such as remark, labels and starred flag.
+func (ContactObjMapper) Update(user types.Uid, contact types.Uid, update map[string]interface{}) error {
+	update["UpdatedAt"] = types.TimeNow()
+	return adp.ContactUpdate(user, contact, update)
 }
 
 func (ContactObjMapper) IsAdded(user types.Uid, contact types.Uid) (bool, error) {
 	return adp.ContactIsAdd(user, contact)
 }
 
+// SetRevoked marks the removed friendship as having revoked the user's permission to write into
+// the P2P topic with the contact, or clears the mark.
+func (ContactObjMapper) SetRevoked(user types.Uid, contact types.Uid, revoked bool) error {
+	return adp.ContactSetRevoked(user, contact, revoked)
+}
+
+// IsRevoked checks if the removal of the friendship revoked the user's permission to write into
+// the P2P topic with the contact.
+func (ContactObjMapper) IsRevoked(user types.Uid, contact types.Uid) (bool, error) {
+	return adp.ContactIsRevoked(user, contact)
+}
+
+// HaveCommon checks if the two users have at least one contact in common.
+func (ContactObjMapper) HaveCommon(one types.Uid, two types.Uid) (bool, error) {
+	first, err := adp.ContactForUser(one, nil)
+	if err != nil || len(first) == 0 {
+		return false, err
+	}
+	second, err := adp.ContactForUser(two, nil)
+	if err != nil {
+		return false, err
+	}
+
+	contacts := make(map[string]bool, len(first))
+	for i := range first {
+		contacts[first[i].Contact] = true
+	}
+	for i := range second {
+		if contacts[second[i].Contact] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// BlockListObjMapper is a struct to hold methods for persistence mapping of users' block lists.
+type BlockListObjMapper struct{}
+
+// BlockList is an instance of BlockListObjMapper to map methods to.
+var BlockList BlockListObjMapper
+
+// Add adds target to user's block list.
+func (BlockListObjMapper) Add(user types.Uid, target types.Uid) error {
+	return adp.BlockAdd(user, target)
+}
+
+// Delete removes target from user's block list.
+func (BlockListObjMapper) Delete(user types.Uid, target types.Uid) error {
+	return adp.BlockDelete(user, target)
+}
+
+// GetAll returns users blocked by the user.
+func (BlockListObjMapper) GetAll(user types.Uid) ([]types.Uid, error) {
+	return adp.BlockList(user)
+}
+
+// IsBlocked checks if target is blocked by the user.
+func (BlockListObjMapper) IsBlocked(user types.Uid, target types.Uid) (bool, error) {
+	return adp.BlockIsBlocked(user, target)
+}
+
 // Registered authentication handlers.
 var authHandlers map[string]auth.AuthHandler
 
@@ -897,6 +1293,34 @@ func UseMediaHandler(name, config string) error {
 	return mediaHandler.Init(config)
 }
 
+// Registered embedded full-text search indexes.
+var searchIndexes map[string]search.Index
+
+// RegisterSearchIndex saves reference to an embedded full-text search index.
+func RegisterSearchIndex(name string, idx search.Index) {
+	if searchIndexes == nil {
+		searchIndexes = make(map[string]search.Index)
+	}
+
+	if idx == nil {
+		panic("RegisterSearchIndex: index is nil")
+	}
+	if _, dup := searchIndexes[name]; dup {
+		panic("RegisterSearchIndex: called twice for index " + name)
+	}
+	searchIndexes[name] = idx
+}
+
+// UseSearchIndex sets specified embedded index to be used for full-text message search instead
+// of the search provided by the database adapter.
+func UseSearchIndex(name, config string) error {
+	searchIndex = searchIndexes[name]
+	if searchIndex == nil {
+		panic("UseSearchIndex: unknown index '" + name + "'")
+	}
+	return searchIndex.Init(config)
+}
+
 // FileMapper is a struct to map methods used for file handling.
 type FileMapper struct{}
 
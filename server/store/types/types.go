@@ -381,6 +381,45 @@ type User struct {
 
 	// Info on known devices, used for push notifications
 	Devices map[string]*DeviceDef
+
+	// Privacy settings
+	Privacy Privacy
+}
+
+// Who may send contact requests to the user
+const (
+	// Anyone (default)
+	ContactRequestsAll = "all"
+	// Only users who have a common contact with the user
+	ContactRequestsFoF = "fof"
+	// Nobody
+	ContactRequestsNone = "none"
+	// Anyone who answers the verification question
+	ContactRequestsVerify = "verify"
+)
+
+// Privacy is user's privacy settings.
+type Privacy struct {
+	// Who may send contact requests: ContactRequestsAll, ContactRequestsFoF, ContactRequestsNone or ContactRequestsVerify
+	ContactRequests string `json:"ContactRequests,omitempty"`
+	// Verification question and the expected answer for ContactRequestsVerify
+	Question string `json:"Question,omitempty"`
+	Answer   string `json:"Answer,omitempty"`
+}
+
+// Scan is an implementation of Scanner interface so the value can be read from SQL DBs.
+// NULL is read as default settings.
+func (p *Privacy) Scan(val interface{}) error {
+	if val == nil {
+		*p = Privacy{}
+		return nil
+	}
+	return json.Unmarshal(val.([]byte), p)
+}
+
+// Value implements sql's driver.Valuer interface.
+func (p Privacy) Value() (driver.Value, error) {
+	return json.Marshal(p)
 }
 
 // AccessMode is a definition of access mode bits.
@@ -658,12 +697,24 @@ type Credential struct {
 	Retries int
 }
 
+// Forgot is a request to recover a forgotten authentication secret.
 type Forgot struct {
 	ObjHeader
-	Token []byte
-	Tel   string
+	// User who requested the recovery
 	UserId string
-	Done  bool
+	// Credential used for recovery - method and value, e.g. `tel` and `17025550001`
+	Method string
+	Value  string
+	// Temporary token which permits resetting the secret
+	Token []byte
+	// Time when the request expires
+	ExpiresAt time.Time
+	// If the token was already used to reset the secret
+	Done bool
+	// One-time code sent through the credential which the user must respond with to get the token
+	Code string
+	// Count of failed attempts to respond with the code
+	Retries int
 }
 
 // Subscription to a topic
@@ -922,6 +973,52 @@ type Message struct {
 	From    string
 	Head    MessageHeaders `json:"Head,omitempty"`
 	Content interface{}
+	// Time of the last edit or nil if the message was never edited
+	EditedAt *time.Time `json:"EditedAt,omitempty"`
+	// Earlier versions of the content, oldest first
+	Revisions MessageRevisions `json:"Revisions,omitempty"`
+	// The message was hard-deleted by the sender and is shown to subscribers as recalled
+	Recalled bool `json:"Recalled,omitempty"`
+	// SeqId of the message this message replies to, zero if the message is not a reply
+	ReplyTo int `json:"ReplyTo,omitempty"`
+}
+
+// MessageRevision is an earlier version of the content of an edited message.
+type MessageRevision struct {
+	// Time when this version was published
+	Timestamp time.Time
+	Content   interface{}
+}
+
+// MessageRevisions is needed to attach Scan() to.
+type MessageRevisions []MessageRevision
+
+// Scan implements sql.Scanner interface.
+func (mr *MessageRevisions) Scan(val interface{}) error {
+	return json.Unmarshal(val.([]byte), mr)
+}
+
+// Value implements sql's driver.Valuer interface.
+func (mr MessageRevisions) Value() (driver.Value, error) {
+	return json.Marshal(mr)
+}
+
+// Reaction is an emoji reaction of a user to a message.
+type Reaction struct {
+	CreatedAt time.Time
+	Topic     string
+	SeqId     int
+	// UID as string of the user who reacted
+	User  string
+	Emoji string
+}
+
+// ReactionCount is the number of users who reacted to a message with the same emoji.
+type ReactionCount struct {
+	Emoji string
+	Count int
+	// The user requesting the count is one of those who reacted
+	Mine bool
 }
 
 type ContactMessage struct {
@@ -932,14 +1029,37 @@ type ContactMessage struct {
 	Contact string
 	//Message state
 	State int
+	//Optional greeting from the sender
+	Message string
+	//Where the sender found the receiver: "search", "qrcode", "card" or group topic name
+	Source string
+	//Time when the pending request expires, nil if never
+	ExpiresAt *time.Time
 	//Target user's info
 	Public interface{}
 }
 
+// IsPending checks if the request is neither accepted nor rejected yet.
+func (cm *ContactMessage) IsPending() bool {
+	state := ContactMessageState(cm.State)
+	return state == Add || state == BeAddedUnread || state == BeAdded
+}
+
+// IsExpired checks if the request is pending past its expiration time.
+func (cm *ContactMessage) IsExpired(now time.Time) bool {
+	return cm.IsPending() && cm.ExpiresAt != nil && cm.ExpiresAt.Before(now)
+}
+
 type Contact struct {
 	ObjHeader
 	User    string `json:"user,omitempty"`
 	Contact string `json:"contact,omitempty"`
+	// Private alias of the contact visible to the user only
+	Remark string
+	// Custom groups the user has assigned the contact to
+	Labels StringSlice
+	// Contact is marked as favorite
+	Starred bool
 	// Contact's info
 	Public interface{}
 }
@@ -1021,13 +1141,25 @@ type QueryOpt struct {
 	User            Uid
 	Topic           string
 	IfModifiedSince *time.Time
+	// Contact query: return contacts with this label only
+	Label string
 	// ID-based query parameters: Messages
 	Since  int
 	Before int
+	// Messages: return replies to the message with this SeqId only
+	Thread int
 	// Common parameter
 	Limit int
 }
 
+// SearchScope limits full-text message search to one topic and a range of message seq IDs,
+// Since inclusive (closed), Before exclusive (open). Zero values mean no limit.
+type SearchScope struct {
+	Topic  string
+	Since  int
+	Before int
+}
+
 type ContactMessageState int
 
 const (
@@ -1038,8 +1170,43 @@ const (
 	BeRejectedUnread
 	BeRejected
 	Agree
+	// Expired is reported for pending requests past their expiration time. It's not stored.
+	Expired
 )
 
+// contactMessageTransitions lists the states a stored contact request may change to. The sender's
+// copy goes Add -> Agree or Add -> BeRejectedUnread -> BeRejected, the receiver's copy goes
+// BeAddedUnread -> BeAdded -> Agree or Reject. Agree, Reject and BeRejected are final.
+var contactMessageTransitions = map[ContactMessageState][]ContactMessageState{
+	Add:              {Agree, BeRejectedUnread},
+	BeAddedUnread:    {BeAdded, Agree, Reject},
+	BeAdded:          {Agree, Reject},
+	BeRejectedUnread: {BeRejected},
+}
+
+// CanChangeTo checks if a contact request in state s may be changed to the given state.
+func (s ContactMessageState) CanChangeTo(to ContactMessageState) bool {
+	for _, next := range contactMessageTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// PrevStates returns the states from which a contact request may be changed to state s.
+func (s ContactMessageState) PrevStates() []ContactMessageState {
+	var prev []ContactMessageState
+	for from, next := range contactMessageTransitions {
+		for _, to := range next {
+			if to == s {
+				prev = append(prev, from)
+			}
+		}
+	}
+	return prev
+}
+
 // TopicCat is an enum of topic categories.
 type TopicCat int
 
@@ -74,6 +74,8 @@ type Topic struct {
 	// User's contact list (not nil for 'me' topic only).
 	// The map keys are UserIds for P2P topics and grpXXX for group topics.
 	perSubs map[string]perSubsData
+	// Users blocked by the owner of the 'me' topic (not nil for 'me' topic only).
+	blocked map[types.Uid]bool
 
 	// Sessions attached to this topic.
 	// A session may represent more than one subsription.
@@ -98,6 +100,10 @@ type Topic struct {
 	exit chan *shutDown
 	// Flag which tells topic to stop acception requests: hub is in the process of shutting it down
 	suspended atomicBool
+	// Flag which tells other goroutines that the owner of the 'me' topic has attached sessions.
+	ownerOnline atomicBool
+	// P2P topic only: copy of perUserData.blocked for other goroutines, map[types.Uid]bool.
+	peerBlocks atomic.Value
 }
 
 type atomicBool int32
@@ -125,6 +131,8 @@ type perUserData struct {
 	public    interface{}
 	topicName string
 	deleted   bool
+	// The user has blocked the other party of the topic.
+	blocked bool
 }
 
 // perSubsData holds user's (on 'me' topic) cache of subscription data
@@ -231,6 +239,7 @@ func (t *Topic) run(hub *Hub) {
 					pud.online--
 					switch t.cat {
 					case types.TopicCatMe:
+						t.setOwnerOnline(pud.online > 0)
 						mrs := t.mostRecentSession()
 						if mrs == nil {
 							// Last session
@@ -275,7 +284,12 @@ func (t *Topic) run(hub *Hub) {
 
 			var pushRcpt *pushReceipt
 			asUid := types.ParseUserId(msg.from)
-			if msg.Data != nil {
+			if msg.Data != nil && msg.Data.replace > 0 {
+				// Sender edits an earlier message
+				if !t.replyEditMessage(msg, asUid) {
+					continue
+				}
+			} else if msg.Data != nil {
 				if t.isSuspended() {
 					msg.sess.queueOut(ErrLocked(msg.id, t.original(asUid), msg.timestamp))
 					continue
@@ -290,13 +304,26 @@ func (t *Topic) run(hub *Hub) {
 					continue
 				}
 
+				if t.cat == types.TopicCatP2P && t.isBlockedByPeer(from) {
+					// The peer has blocked the sender: pretend the message was accepted.
+					if msg.id != "" {
+						msg.sess.queueOut(NoErrAccepted(msg.id, t.original(asUid), msg.timestamp))
+					}
+					continue
+				}
+
+				if msg.Data.ReplyTo != 0 && !t.checkReplyParent(msg, asUid) {
+					continue
+				}
+
 				if err := store.Messages.Save(&types.Message{
 					ObjHeader: types.ObjHeader{CreatedAt: msg.Data.Timestamp},
 					SeqId:     t.lastID + 1,
 					Topic:     t.name,
 					From:      from.String(),
 					Head:      msg.Data.Head,
-					Content:   msg.Data.Content}, false); err != nil {
+					Content:   msg.Data.Content,
+					ReplyTo:   msg.Data.ReplyTo}, false); err != nil {
 
 					log.Printf("topic[%s]: failed to save message: %v", t.name, err)
 					msg.sess.queueOut(ErrUnknown(msg.id, t.original(asUid), msg.timestamp))
@@ -332,6 +359,22 @@ func (t *Topic) run(hub *Hub) {
 					continue
 				}
 
+				if t.cat == types.TopicCatMe && t.isBlocked(msg.Pres.Src) {
+					// Drop presence notifications from blocked users
+					continue
+				}
+
+				if t.cat == types.TopicCatP2P && (msg.Pres.What == "block" || msg.Pres.What == "unblock") {
+					// One of the users has changed the block list: update the cached state.
+					uid := types.ParseUserId(msg.Pres.Src)
+					if pud, ok := t.perUser[uid]; ok {
+						pud.blocked = msg.Pres.What == "block"
+						t.perUser[uid] = pud
+						t.publishPeerBlocks()
+					}
+					continue
+				}
+
 				what := t.presProcReq(msg.Pres.Src, msg.Pres.What, msg.Pres.wantReply)
 				if t.xoriginal != msg.Pres.Topic || what == "" {
 					// This is just a request for status, don't forward it to sessions
@@ -406,6 +449,12 @@ func (t *Topic) run(hub *Hub) {
 						t.perUser[uid] = pud
 					}
 
+				} else if msg.Info.What == "react" || msg.Info.What == "unreact" {
+					if !t.replyReaction(msg.Info) {
+						// Nothing has changed
+						continue
+					}
+
 				} else if msg.Info.What == "ctread" {
 					var state types.ContactMessageState
 					if msg.Info.ContactState == 1 {
@@ -413,102 +462,17 @@ func (t *Topic) run(hub *Hub) {
 					} else if msg.Info.ContactState == 4 {
 						state = types.BeRejected
 					}
-					if err := store.ContMsg.UpdateById(msg.Info.ContactId, state); err != nil {
+					if err := store.ContMsg.UpdateById(types.ParseUserId(msg.Info.From),
+						msg.Info.ContactId, state); err != nil {
 						continue
 					}
 				}
-			} else if msg.Contact != nil {
-
-				user := types.ParseUserId(msg.Contact.Sender)
-				contact := types.ParseUserId(msg.Contact.Receiver)
-
-				switch msg.Contact.What {
-				case "add":
-					//when someone add contact:
-					//	1. has been added
-					//		fail
-					//  2. other added
-					// 		add contact
-					//	3. Not added
-					//      save add contact message also notify contact
-
-					isAddedContact, contactErr := store.Contact.IsAdded(user, contact)
-					if contactErr != nil {
-						msg.sess.queueOut(ErrMalformed(msg.id, t.original(asUid), msg.timestamp))
-						continue
-					}
-					if isAddedContact {
-						msg.sess.queueOut(ErrAlreadyExists(msg.id, t.original(asUid), msg.timestamp))
-						continue
-					}
-
-					isAdd, _ := store.ContMsg.IsAdded(user, contact)
-					isBeAdd, _ := store.ContMsg.IsAdded(contact, user)
-
-					if isAdd && isBeAdd {
-						if err := store.ContMsg.Update(user, contact, types.Add); err != nil {
-							msg.sess.queueOut(ErrMalformed(msg.id, t.original(asUid), msg.timestamp))
-							continue
-						}
-
-						if err := store.ContMsg.Update(contact, user, types.BeAddedUnread); err != nil {
-							msg.sess.queueOut(ErrMalformed(msg.id, t.original(asUid), msg.timestamp))
-							continue
-						}
-					} else {
-						contactId, err := store.ContMsg.Save(user, contact)
-						if err != nil {
-							msg.sess.queueOut(ErrUnknown(msg.id, t.original(asUid), msg.timestamp))
-							continue
-						}
-
-						t.presContactMessage("ctadd", user, contact, contactId)
-						pushRcpt = t.makeContactReceipt(contact, "收到一条好友请求")
-					}
-				case "reject":
-					if err := store.ContMsg.Update(user, contact, types.Reject); err != nil {
-						msg.sess.queueOut(ErrUnknown(msg.id, t.original(asUid), msg.timestamp))
-						continue
-					}
-					if err := store.ContMsg.Update(contact, user, types.BeRejectedUnread); err != nil {
-						msg.sess.queueOut(ErrUnknown(msg.id, t.original(asUid), msg.timestamp))
-						continue
-					}
-					t.presContactMessage("ctreject", user, contact, msg.Contact.ContactId)
-					pushRcpt = t.makeContactReceipt(contact, "收到拒绝添加联系人请求")
-				case "agree":
-					if err := store.ContMsg.Update(user, contact, types.Agree); err != nil {
-						msg.sess.queueOut(ErrUnknown(msg.id, t.original(asUid), msg.timestamp))
-						continue
-					}
-					if err := store.ContMsg.Update(contact, user, types.Agree); err != nil {
-						msg.sess.queueOut(ErrUnknown(msg.id, t.original(asUid), msg.timestamp))
-						continue
-					}
-
-					if err := store.Contact.Add(user, contact); err != nil {
-						msg.sess.queueOut(ErrUnknown(msg.id, t.original(asUid), msg.timestamp))
-						continue
-					}
-					t.presContactMessage("ctagree", user, contact, msg.Contact.ContactId)
-					pushRcpt = t.makeContactReceipt(contact, "收到有人同意添加你为好友消息")
-				}
-				msg.sess.queueOut(NoErr(msg.id, t.original(asUid), msg.timestamp))
-			} else if msg.Signal != nil {
-				subs, e := store.Topics.GetSubs(msg.Signal.Target, nil)
-				if e != nil {
-					msg.sess.queueOut(ErrUnknown(msg.id, t.original(asUid), msg.timestamp))
-					continue
-				}
-				t.presSignal(msg.Signal.Command, msg.Signal.Room, asUid, subs)
-				if msg.Signal.Command == "audio" || msg.Signal.Command == "video" {
-					pushRcpt = t.makeSignalReceipt(asUid, subs, "你收到一个新来电", msg.Signal.Room, msg.Signal.Command)
-				}
-
-				if msg.Signal.Command == "leave" {
-					pushRcpt = t.makeSignalReceipt(asUid, subs, "对方已经取消", msg.Signal.Room, msg.Signal.Command)
-				}
+			} else if msg.Signal != nil && t.cat == types.TopicCatMe && t.isBlocked(msg.Signal.From) {
+				// Drop signals from blocked users
+				continue
 			}
+			// {contact} and {signal} sent by the user are processed by the social handler and
+			// arrive here for delivery to the user's other sessions only.
 
 			// Broadcast the message. Only {data}, {pres}, {info} {contact} are broadcastable.
 			// {meta} and {ctrl} are sent to the session only
@@ -636,6 +600,31 @@ func (t *Topic) run(hub *Hub) {
 						log.Printf("topic[%s] meta.Get.Tags failed: %s", t.name, err)
 					}
 				}
+				if meta.what&constMsgMetaBlocked != 0 {
+					if err := t.replyGetBlocked(meta.sess, asUid, meta.pkt.Get.Id); err != nil {
+						log.Printf("topic[%s] meta.Get.Blocked failed: %s", t.name, err)
+					}
+				}
+				if meta.what&constMsgMetaPrivacy != 0 {
+					if err := t.replyGetPrivacy(meta.sess, asUid, meta.pkt.Get.Id); err != nil {
+						log.Printf("topic[%s] meta.Get.Privacy failed: %s", t.name, err)
+					}
+				}
+				if meta.what&constMsgMetaIce != 0 {
+					if err := t.replyGetIce(meta.sess, asUid, meta.pkt.Get.Id); err != nil {
+						log.Printf("topic[%s] meta.Get.Ice failed: %s", t.name, err)
+					}
+				}
+				if meta.what&constMsgMetaCall != 0 {
+					if err := t.replyGetCall(meta.sess, asUid, meta.pkt.Get.Id); err != nil {
+						log.Printf("topic[%s] meta.Get.Call failed: %s", t.name, err)
+					}
+				}
+				if meta.what&constMsgMetaSearch != 0 {
+					if err := t.replyGetSearch(meta.sess, asUid, meta.pkt.Get.Id, meta.pkt.Get.Search); err != nil {
+						log.Printf("topic[%s] meta.Get.Search failed: %s", t.name, err)
+					}
+				}
 
 			case meta.pkt.Set != nil:
 				// Set request
@@ -657,6 +646,21 @@ func (t *Topic) run(hub *Hub) {
 						log.Printf("topic[%s] meta.Set.Tags failed: %v", t.name, err)
 					}
 				}
+				if meta.what&constMsgMetaBlocked != 0 {
+					if err := t.replySetBlock(meta.sess, asUid, meta.pkt.Set); err != nil {
+						log.Printf("topic[%s] meta.Set.Block failed: %v", t.name, err)
+					}
+				}
+				if meta.what&constMsgMetaPrivacy != 0 {
+					if err := t.replySetPrivacy(meta.sess, asUid, meta.pkt.Set); err != nil {
+						log.Printf("topic[%s] meta.Set.Privacy failed: %v", t.name, err)
+					}
+				}
+				if meta.what&constMsgMetaContact != 0 {
+					if err := t.replySetContact(meta.sess, asUid, meta.pkt.Set); err != nil {
+						log.Printf("topic[%s] meta.Set.Contact failed: %v", t.name, err)
+					}
+				}
 
 			case meta.pkt.Del != nil:
 				// Del request
@@ -672,6 +676,8 @@ func (t *Topic) run(hub *Hub) {
 					err = t.replyDelContactMessage(hub, meta.sess, asUid, meta.pkt.Del)
 				case constMsgDelContact:
 					err = t.replyDelContact(hub, meta.sess, asUid, meta.pkt.Del)
+				case constMsgDelRecall:
+					err = t.replyRecallMsg(meta.sess, asUid, meta.pkt.Del)
 				}
 
 				if err != nil {
@@ -697,6 +703,8 @@ func (t *Topic) run(hub *Hub) {
 			if t.cat == types.TopicCatMe {
 				uaTimer.Stop()
 				t.presUsersOfInterest("off", currentUA)
+				// User is gone, terminate his call, if any.
+				globals.callRegistry.userOffline(types.ParseUserId(t.name))
 			} else if t.cat == types.TopicCatGrp {
 				t.presSubsOffline("off", nilPresParams, nilPresFilters, "", false)
 			}
@@ -713,6 +721,7 @@ func (t *Topic) run(hub *Hub) {
 			if sd.reason == StopDeleted {
 				if t.cat == types.TopicCatGrp {
 					t.presSubsOffline("gone", nilPresParams, nilPresFilters, "", false)
+					globals.callRegistry.topicGone(t.name)
 				}
 				// P2P users get "off+remove" earlier in the process
 
@@ -923,6 +932,9 @@ func (t *Topic) subCommonReply(h *Hub, sreg *sessionJoin) error {
 	pud := t.perUser[asUid]
 	pud.online++
 	t.perUser[asUid] = pud
+	if t.cat == types.TopicCatMe {
+		t.setOwnerOnline(true)
+	}
 
 	sreg.sess.addSub(t.name, &Subscription{
 		broadcast: t.broadcast,
@@ -1303,7 +1315,11 @@ func (t *Topic) approveSub(h *Hub, sess *Session, asUid, target types.Uid, set *
 	// Access mode has changed.
 	var changed bool
 	if oldGiven != userData.modeGiven {
-		t.notifySubChange(target, asUid, oldWant, oldGiven, userData.modeWant, userData.modeGiven, sess.sid)
+		var skipSid string
+		if sess != nil {
+			skipSid = sess.sid
+		}
+		t.notifySubChange(target, asUid, oldWant, oldGiven, userData.modeWant, userData.modeGiven, skipSid)
 		changed = true
 	}
 
@@ -1523,6 +1539,9 @@ func (t *Topic) replySetDesc(sess *Session, asUid types.Uid, set *MsgClientSet)
 		}
 		if public, ok := core["Public"]; ok {
 			t.public = public
+			if t.cat == types.TopicCatMe {
+				globals.pushTexts.forgetSender(asUid)
+			}
 		}
 	} else if t.cat == types.TopicCatFnd {
 		// Assign per-session fnd.Public.
@@ -1579,6 +1598,9 @@ func (t *Topic) replyGetContactMessage(sess *Session, asUid types.Uid, id string
 			mts.Sender = msg.User
 			mts.Receiver = msg.Contact
 			mts.State = msg.State
+			mts.Message = msg.Message
+			mts.Source = msg.Source
+			mts.Expires = msg.ExpiresAt
 			mts.Public = msg.Public
 			meta.ContactMsg = append(meta.ContactMsg, mts)
 		}
@@ -1604,6 +1626,7 @@ func (t *Topic) replyGetContact(sess *Session, asUid types.Uid, id string, req *
 
 	if err != nil {
 		sess.queueOut(decodeStoreError(err, id, t.original(asUid), now, nil))
+		return err
 	}
 
 	if len(contacts) > 0 {
@@ -1614,12 +1637,20 @@ func (t *Topic) replyGetContact(sess *Session, asUid types.Uid, id string, req *
 			contact := contacts[i]
 			mf.Id = contact.Id
 			mf.CreateAt = &contact.CreatedAt
+			mf.UpdateAt = &contact.UpdatedAt
+			mf.DeleteAt = contact.DeletedAt
 			mf.User = contact.User
 			mf.Contact = contact.Contact
+			mf.Remark = contact.Remark
+			mf.Labels = contact.Labels
+			mf.Starred = contact.Starred
 			mf.Public = contact.Public
 			meta.Contact = append(meta.Contact, mf)
 		}
 		sess.queueOut(&ServerComMessage{Meta: meta})
+	} else if req != nil && req.IfModifiedSince != nil {
+		// Incremental sync: nothing has changed since the last request.
+		sess.queueOut(InfoNotModified(id, t.original(asUid), now))
 	} else {
 		sess.queueOut(NoErr(id, t.original(asUid), now))
 	}
@@ -1936,7 +1967,7 @@ func (t *Topic) replyGetData(sess *Session, asUid types.Uid, id string, req *Msg
 	now := types.TimeNow()
 	toriginal := t.original(asUid)
 
-	if req != nil && (req.IfModifiedSince != nil || req.User != "" || req.Topic != "") {
+	if req != nil && (req.IfModifiedSince != nil || req.User != "" || req.Topic != "" || req.Thread < 0) {
 		sess.queueOut(ErrMalformed(id, toriginal, now))
 		return errors.New("invalid MsgGetOpts query")
 	}
@@ -1956,6 +1987,22 @@ func (t *Topic) replyGetData(sess *Session, asUid types.Uid, id string, req *Msg
 		// clients to process.
 		if messages != nil {
 			count = len(messages)
+
+			seqIds := make([]int, count)
+			for i := range messages {
+				seqIds[i] = messages[i].SeqId
+			}
+			reactions, err := store.Reactions.GetCounts(t.name, asUid, seqIds)
+			if err != nil {
+				sess.queueOut(ErrUnknown(id, toriginal, now))
+				return err
+			}
+			replies, err := store.Messages.GetReplyCounts(t.name, seqIds)
+			if err != nil {
+				sess.queueOut(ErrUnknown(id, toriginal, now))
+				return err
+			}
+
 			for i := count - 1; i >= 0; i-- {
 				mm := &messages[i]
 				sess.queueOut(&ServerComMessage{Data: &MsgServerData{
@@ -1964,7 +2011,14 @@ func (t *Topic) replyGetData(sess *Session, asUid types.Uid, id string, req *Msg
 					SeqId:     mm.SeqId,
 					From:      types.ParseUid(mm.From).UserId(),
 					Timestamp: mm.CreatedAt,
-					Content:   mm.Content}})
+					Content:   mm.Content,
+					EditedAt:  mm.EditedAt,
+					Revisions: messageRevisions(mm.Revisions),
+					DeletedAt: mm.DeletedAt,
+					Recalled:  mm.Recalled,
+					Reactions: messageReactions(reactions[mm.SeqId]),
+					ReplyTo:   mm.ReplyTo,
+					Replies:   replies[mm.SeqId]}})
 			}
 		}
 	}
@@ -2235,14 +2289,44 @@ func (t *Topic) replyDelContactMessage(h *Hub, sess *Session, asUid types.Uid, d
 	if err := store.ContMsg.Delete(types.ParseUserId(del.DelCtMsgUser), types.ParseUserId(del.DelCtMsgContact)); err != nil {
 		return err
 	}
-	t.presContactMessage("ctmdel", types.ParseUserId(del.DelCtMsgUser), types.ParseUserId(del.DelCtMsgContact), del.DelCtMsgId)
+	presContactMessage("ctmdel", types.ParseUserId(del.DelCtMsgUser), types.ParseUserId(del.DelCtMsgContact), del.DelCtMsgId)
 	sess.queueOut(NoErr(del.Id, t.original(asUid), now))
 	return nil
 }
 
+// replyDelContact removes the friendship between the user and the contact on both sides and notifies
+// both users. If del.Hard is set, the users also lose the permission to write into their P2P topic.
 func (t *Topic) replyDelContact(h *Hub, sess *Session, asUid types.Uid, del *MsgClientDel) error {
-	_ = store.Contact.Delete(types.ParseUserId(del.DelCtMsgUser), types.ParseUserId(del.DelCtMsgContact))
-	_ = store.ContMsg.Delete(types.ParseUserId(del.DelCtMsgUser), types.ParseUserId(del.DelCtMsgContact))
+	now := types.TimeNow()
+
+	contact := types.ParseUserId(del.DelCtMsgContact)
+	if del.DelCtMsgUser != "" && types.ParseUserId(del.DelCtMsgUser) != asUid {
+		sess.queueOut(ErrPermissionDenied(del.Id, t.original(asUid), now))
+		return errors.New("del.contact: cannot delete contacts of another user")
+	}
+	if contact.IsZero() || contact == asUid {
+		sess.queueOut(ErrMalformed(del.Id, t.original(asUid), now))
+		return errors.New("del.contact: invalid contact")
+	}
+
+	if err := store.Contact.Delete(asUid, contact); err != nil {
+		sess.queueOut(decodeStoreError(err, del.Id, t.original(asUid), now, nil))
+		return err
+	}
+	_ = store.ContMsg.Delete(asUid, contact)
+
+	if del.Hard {
+		if err := setP2PWriteAccess(h, asUid, contact, false); err != nil {
+			log.Printf("topic[%s]: failed to revoke p2p access: %v", t.name, err)
+		}
+	}
+
+	// Tell the former contact and the user's other sessions that the friendship is gone.
+	presSingleUserOfflineOffline(contact, asUid.UserId(), "ctdel", nilPresParams, "")
+	presSingleUserOfflineOffline(asUid, contact.UserId(), "ctdel", nilPresParams, sess.sid)
+
+	pluginContact("del", asUid, contact, del.DelCtId)
+	sess.queueOut(NoErr(del.Id, t.original(asUid), now))
 	return nil
 }
 
@@ -2360,6 +2444,9 @@ func (t *Topic) evictUser(uid types.Uid, unsub bool, skip string) {
 		pud.online = 0
 		t.perUser[uid] = pud
 	}
+	if t.cat == types.TopicCatMe {
+		t.setOwnerOnline(false)
+	}
 
 	// Detach all user's sessions
 	msg := NoErrEvicted("", t.original(uid), now)
@@ -2518,10 +2605,10 @@ func (t *Topic) makeDataReceipt(fromUid types.Uid, data *MsgServerData) *pushRec
 			SeqId:     data.SeqId,
 			Content:   data.Content},
 		Payload2: push.Payload2{
-			Type:    push.PayloadMessage,
-			Plat:    push.ALL,
-			Title:   "",
-			Content: "你收到一条消息",
+			Type:       push.PayloadMessage,
+			Plat:       push.ALL,
+			Title:      "",
+			CollapseId: topic,
 			Params: map[string]interface{}{
 				"topic":  topic,
 				"action": "message"},
@@ -2532,10 +2619,8 @@ func (t *Topic) makeDataReceipt(fromUid types.Uid, data *MsgServerData) *pushRec
 		if uid != fromUid &&
 			(t.perUser[uid].modeWant & t.perUser[uid].modeGiven).IsPresencer() &&
 			!t.perUser[uid].deleted {
-			if topic := globals.hub.topicGet(uid.UserId()); topic != nil {
-				if topic.perUser[uid].online > 0 {
-					continue
-				}
+			if isUserOnline(uid) {
+				continue
 			}
 			receipt.To[i].User = uid
 			idx[uid] = i
@@ -2545,38 +2630,87 @@ func (t *Topic) makeDataReceipt(fromUid types.Uid, data *MsgServerData) *pushRec
 	if i == 0 {
 		return nil
 	}
+	receipt.Payload2.Content, receipt.Payload2.Texts = globals.pushTexts.render(pushEventMessage, fromUid)
+
+	return &pushReceipt{rcpt: &receipt, uidMap: idx}
+}
+
+// makeRecallReceipt creates a push receipt which tells devices of subscribers to remove notifications
+// of the message recalled by fromUid.
+func (t *Topic) makeRecallReceipt(fromUid types.Uid, seq int, ts time.Time) *pushReceipt {
+	idx := make(map[types.Uid]int, t.subsCount())
+
+	topic := t.xoriginal
+	if t.cat == types.TopicCatP2P {
+		topic = fromUid.UserId()
+	}
+
+	receipt := push.Receipt{
+		To: make([]push.Recipient, t.subsCount()),
+		Payload: push.Payload{
+			Topic:     topic,
+			From:      fromUid.UserId(),
+			Timestamp: ts,
+			SeqId:     seq},
+		Payload2: push.Payload2{
+			Type:       push.PayloadRecall,
+			Plat:       push.ALL,
+			CollapseId: topic,
+			Params: map[string]interface{}{
+				"topic":  topic,
+				"seq":    seq,
+				"action": "recall"},
+		}}
+
+	// Users who are online now may still have notifications displayed on other devices,
+	// so they are not skipped.
+	i := 0
+	for uid := range t.perUser {
+		if uid != fromUid &&
+			(t.perUser[uid].modeWant & t.perUser[uid].modeGiven).IsPresencer() &&
+			!t.perUser[uid].deleted {
+			receipt.To[i].User = uid
+			idx[uid] = i
+			i++
+		}
+	}
+	if i == 0 {
+		return nil
+	}
+	receipt.To = receipt.To[:i]
 
 	return &pushReceipt{rcpt: &receipt, uidMap: idx}
 }
 
-func (t *Topic) makeContactReceipt(toUser types.Uid, msg string) *pushReceipt {
+// makeContactReceipt creates a push receipt for a contact request event sent by fromUid to toUser.
+func makeContactReceipt(fromUid, toUser types.Uid, event string) *pushReceipt {
 	idx := make(map[types.Uid]int, 1)
 	params := make(map[string]interface{})
 	params["action"] = "contact"
 	receipt := push.Receipt{
 		To: make([]push.Recipient, 1),
 		Payload2: push.Payload2{
-			Type:    push.PayloadContact,
-			Plat:    push.ALL,
-			Title:   "",
-			Content: msg,
-			Params:  params,
+			Type:       push.PayloadContact,
+			Plat:       push.ALL,
+			Title:      "",
+			CollapseId: fromUid.UserId(),
+			Params:     params,
 		}}
 
-	if topic := globals.hub.topicGet(toUser.UserId()); topic != nil {
-		if topic.perUser[toUser].online > 0 {
-			return nil
-		}
+	if isUserOnline(toUser) {
+		return nil
 	}
 	receipt.To[0].User = toUser
+	receipt.Payload2.Content, receipt.Payload2.Texts = globals.pushTexts.render(event, fromUid)
 
 	idx[toUser] = 0
 
 	return &pushReceipt{rcpt: &receipt, uidMap: idx}
 }
 
-func (t *Topic) makeSignalReceipt(fromUid types.Uid, subs []types.Subscription, msg string, room string, command string) *pushReceipt {
-	idx := make(map[types.Uid]int, 1)
+// makeSignalReceipt creates a push receipt for call notifications. Users who are online are skipped.
+func makeSignalReceipt(fromUid types.Uid, to []types.Uid, event string, room string, command string) *pushReceipt {
+	idx := make(map[types.Uid]int, len(to))
 
 	params := make(map[string]interface{})
 	params["action"] = "signal"
@@ -2590,33 +2724,36 @@ func (t *Topic) makeSignalReceipt(fromUid types.Uid, subs []types.Subscription,
 		}
 	}
 	var sound string
-	if command == "audio" || command == "video" {
+	incoming := command == "audio" || command == "video"
+	if incoming {
 		sound = "call.caf"
 	}
 
 	receipt := push.Receipt{
-		To: make([]push.Recipient, 1),
+		To: make([]push.Recipient, 0, len(to)),
 		Payload2: push.Payload2{
-			Type:    push.PayloadSignal,
-			Plat:    push.ALL,
-			Title:   "",
-			Content: msg,
-			Sound:   sound,
-			Params:  params,
+			Type:         push.PayloadSignal,
+			Plat:         push.ALL,
+			Title:        "",
+			Sound:        sound,
+			CollapseId:   room,
+			IncomingCall: incoming,
+			Params:       params,
 		}}
 
-	for i, sub := range subs {
-		uid := types.ParseUid(sub.User)
+	for _, uid := range to {
 		if uid != fromUid {
-			if topic := globals.hub.topicGet(uid.UserId()); topic != nil {
-				if topic.perUser[uid].online > 0 {
-					continue
-				}
+			if isUserOnline(uid) {
+				continue
 			}
-			receipt.To[i].User = uid
-			idx[uid] = i
+			idx[uid] = len(receipt.To)
+			receipt.To = append(receipt.To, push.Recipient{User: uid})
 		}
 	}
+	if len(receipt.To) == 0 {
+		return nil
+	}
+	receipt.Payload2.Content, receipt.Payload2.Texts = globals.pushTexts.render(event, fromUid)
 	return &pushReceipt{rcpt: &receipt, uidMap: idx}
 }
 
@@ -2644,6 +2781,22 @@ func (t *Topic) isSuspended() bool {
 	return atomic.LoadInt32((*int32)(&t.suspended)) != 0
 }
 
+func (t *Topic) setOwnerOnline(online bool) {
+	var val int32
+	if online {
+		val = 1
+	}
+	atomic.StoreInt32((*int32)(&t.ownerOnline), val)
+}
+
+// isUserOnline checks if the user has sessions attached to the 'me' topic. Safe to call from any goroutine.
+func isUserOnline(uid types.Uid) bool {
+	if t := globals.hub.topicGet(uid.UserId()); t != nil {
+		return atomic.LoadInt32((*int32)(&t.ownerOnline)) != 0
+	}
+	return false
+}
+
 // Get topic name suitable for the given client
 func (t *Topic) original(uid types.Uid) string {
 	if t.cat != types.TopicCatP2P {
@@ -127,7 +127,7 @@ func replyCreateUser(s *Session, msg *ClientComMessage, rec *auth.Rec) {
 	for i := range creds {
 		cr := &creds[i]
 		vld := store.GetValidator(cr.Method)
-		if err := vld.Request(user.Uid(), cr.Value, s.lang, cr.Response, tmpToken); err != nil {
+		if err := vld.Request(user.Uid(), cr.Value, s.lang, s.remoteAddr, cr.Response, tmpToken); err != nil {
 			log.Println("s.acc: failed to save or validate credential", err, s.sid)
 			// Delete incomplete user record.
 			store.Users.Delete(user.Uid(), false)
@@ -245,6 +245,16 @@ func replyUpdateUser(s *Session, msg *ClientComMessage, rec *auth.Rec) {
 
 		// TODO(gene): support adding new auth schemes
 
+		var forgot *types.Forgot
+		if rec != nil && rec.Features&auth.FeatureNoLogin != 0 {
+			// Password recovery: the token is valid for one reset only.
+			if forgot, err = store.Forgot.Use(uid, msg.Acc.Token); err != nil {
+				log.Println("replyUpdateUser: invalid or used recovery token", err, s.sid)
+				s.queueOut(decodeStoreError(err, msg.id, "", msg.timestamp, nil))
+				return
+			}
+		}
+
 		rec, err := authhdl.UpdateRecord(&auth.Rec{Uid: uid, Tags: user.Tags}, msg.Acc.Secret)
 		if err != nil {
 			log.Println("replyUpdateUser: failed to update auth secret", err, s.sid)
@@ -255,6 +265,12 @@ func replyUpdateUser(s *Session, msg *ClientComMessage, rec *auth.Rec) {
 		// Tags may have changed, update them
 		store.Users.UpdateTags(uid, rec.Tags, true)
 
+		if forgot != nil {
+			// Whoever forgot the password may still be logged in elsewhere. Log out all other sessions.
+			globals.sessionStore.EvictUser(uid, s.sid)
+			notifySecretReset(uid, forgot.Method, msg.Acc.Scheme, s.lang)
+		}
+
 	} else if msg.Acc.Scheme != "" {
 		// Invalid or unknown auth scheme
 		log.Println("replyUpdateUser: unknown auth scheme", msg.Acc.Scheme, s.sid)
@@ -282,6 +298,27 @@ func replyUpdateUser(s *Session, msg *ClientComMessage, rec *auth.Rec) {
 	pluginAccount(user, plgActUpd)
 }
 
+// notifySecretReset informs the user through all confirmed credentials, except the one used
+// for recovery, that the authentication secret was reset.
+func notifySecretReset(uid types.Uid, skipMethod, scheme, lang string) {
+	creds, err := store.Users.GetAllCred(uid)
+	if err != nil {
+		log.Println("notifySecretReset: failed to get credentials", uid.UserId(), err)
+		return
+	}
+
+	for _, cred := range creds {
+		if !cred.Done || cred.Method == skipMethod {
+			continue
+		}
+		if validator := store.GetValidator(cred.Method); validator != nil {
+			if err := validator.NotifySecretReset(cred.Value, scheme, lang); err != nil {
+				log.Println("notifySecretReset: failed to notify", uid.UserId(), cred.Method, err)
+			}
+		}
+	}
+}
+
 // Request to delete a user:
 // 1. Disable user's login
 // 2. Terminate all user's sessions except the current session.
@@ -221,6 +221,8 @@ func msgOpts2storeOpts(req *MsgGetOpts) *types.QueryOpt {
 			Limit:           req.Limit,
 			Since:           req.SinceId,
 			Before:          req.BeforeId,
+			Label:           req.Label,
+			Thread:          req.Thread,
 		}
 	}
 	return opts
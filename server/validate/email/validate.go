@@ -30,6 +30,8 @@ type validator struct {
 	ResetTemplFile      string   `json:"reset_body_templ"`
 	ValidationSubject   string   `json:"validation_subject"`
 	ResetSubject        string   `json:"reset_subject"`
+	NoticeTemplFile     string   `json:"reset_notice_body_templ"`
+	NoticeSubject       string   `json:"reset_notice_subject"`
 	SendFrom            string   `json:"sender"`
 	SenderPassword      string   `json:"sender_password"`
 	DebugResponse       string   `json:"debug_response"`
@@ -39,6 +41,7 @@ type validator struct {
 	Domains             []string `json:"domains"`
 	htmlValidationTempl *ht.Template
 	htmlResetTempl      *ht.Template
+	htmlNoticeTempl     *ht.Template
 	auth                smtp.Auth
 }
 
@@ -83,6 +86,13 @@ func (v *validator) Init(jsonconf string) error {
 		}
 	}
 
+	if v.NoticeTemplFile != "" && !filepath.IsAbs(v.NoticeTemplFile) {
+		basepath, err := os.Executable()
+		if err == nil {
+			v.NoticeTemplFile = filepath.Join(filepath.Dir(basepath), v.NoticeTemplFile)
+		}
+	}
+
 	v.htmlValidationTempl, err = ht.ParseFiles(v.ValidationTemplFile)
 	if err != nil {
 		return err
@@ -91,6 +101,12 @@ func (v *validator) Init(jsonconf string) error {
 	if err != nil {
 		return err
 	}
+	if v.NoticeTemplFile != "" {
+		v.htmlNoticeTempl, err = ht.ParseFiles(v.NoticeTemplFile)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Initialize random number generator.
 	rand.Seed(time.Now().UnixNano())
@@ -162,7 +178,7 @@ func (v *validator) PreCheck(cred string, params interface{}) error {
 }
 
 // Send a request for confirmation to the user: makes a record in DB  and nothing else.
-func (v *validator) Request(user t.Uid, email, lang, resp string, tmpToken []byte) error {
+func (v *validator) Request(user t.Uid, email, lang, remoteAddr, resp string, tmpToken []byte) error {
 	// Email validator cannot accept an immmediate response.
 	if resp != "" {
 		return t.ErrFailed
@@ -195,7 +211,7 @@ func (v *validator) Request(user t.Uid, email, lang, resp string, tmpToken []byt
 }
 
 // ResetSecret sends a message with instructions for resetting an authentication secret.
-func (v *validator) ResetSecret(email, scheme, lang string, tmpToken []byte) error {
+func (v *validator) ResetSecret(email, scheme, lang, remoteAddr string, tmpToken []byte) error {
 	token := make([]byte, base64.URLEncoding.EncodedLen(len(tmpToken)))
 	base64.URLEncoding.Encode(token, tmpToken)
 	body := new(bytes.Buffer)
@@ -212,6 +228,25 @@ func (v *validator) ResetSecret(email, scheme, lang string, tmpToken []byte) err
 	return nil
 }
 
+// NotifySecretReset sends an email informing the user that the authentication secret was reset.
+func (v *validator) NotifySecretReset(email, scheme, lang string) error {
+	if v.htmlNoticeTempl == nil {
+		return nil
+	}
+
+	body := new(bytes.Buffer)
+	if err := v.htmlNoticeTempl.Execute(body, map[string]interface{}{
+		"Scheme":  scheme,
+		"HostUrl": v.HostUrl}); err != nil {
+		return err
+	}
+
+	// Send email without blocking. Email sending may take long time.
+	go v.send(email, v.NoticeSubject, string(body.Bytes()))
+
+	return nil
+}
+
 // Check checks if the provided validation response matches the expected response.
 // Returns the value of validated credential on success.
 func (v *validator) Check(user t.Uid, resp string) (string, error) {
@@ -0,0 +1,81 @@
+package tel
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rateLimiter limits how often an action can be performed per key, such as a phone number
+// or an IP address. The state is kept in memory of the current node only.
+type rateLimiter struct {
+	lock sync.Mutex
+
+	// Minimum time between two consecutive actions. Zero means no cooldown.
+	cooldown time.Duration
+	// Maximum number of actions per window. Zero means unlimited.
+	limit  int
+	window time.Duration
+
+	// Times of recent actions indexed by key.
+	hits map[string][]time.Time
+	// Last time stale keys were removed.
+	lastSweep time.Time
+}
+
+func newRateLimiter(cooldown time.Duration, limit int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		cooldown: cooldown,
+		limit:    limit,
+		window:   window,
+		hits:     make(map[string][]time.Time),
+	}
+}
+
+// allow checks if the action is permitted for the key and, if so, records it.
+func (rl *rateLimiter) allow(key string, now time.Time) bool {
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+
+	if now.Sub(rl.lastSweep) > rl.window {
+		for k, hits := range rl.hits {
+			if len(hits) == 0 || now.Sub(hits[len(hits)-1]) >= rl.window {
+				delete(rl.hits, k)
+			}
+		}
+		rl.lastSweep = now
+	}
+
+	// Drop actions outside of the window.
+	hits := rl.hits[key]
+	recent := hits[:0]
+	for _, hit := range hits {
+		if now.Sub(hit) < rl.window {
+			recent = append(recent, hit)
+		}
+	}
+	rl.hits[key] = recent
+
+	if len(recent) > 0 && now.Sub(recent[len(recent)-1]) < rl.cooldown {
+		return false
+	}
+	if rl.limit > 0 && len(recent) >= rl.limit {
+		return false
+	}
+
+	rl.hits[key] = append(recent, now)
+	return true
+}
+
+// generateCode returns a random numeric code of the given length, zero-padded.
+func generateCode(length int) (string, error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(length)), nil)
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	code := n.String()
+	return strings.Repeat("0", length-len(code)) + code, nil
+}
@@ -0,0 +1,48 @@
+package tel
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	rl := newRateLimiter(time.Minute, 3, time.Hour)
+	now := time.Now()
+
+	if !rl.allow("15551234567", now) {
+		t.Fatal("first request must be allowed")
+	}
+	if rl.allow("15551234567", now.Add(30*time.Second)) {
+		t.Error("request within cooldown must be rejected")
+	}
+	if !rl.allow("15550000000", now.Add(30*time.Second)) {
+		t.Error("cooldown must not affect other keys")
+	}
+	if !rl.allow("15551234567", now.Add(2*time.Minute)) ||
+		!rl.allow("15551234567", now.Add(4*time.Minute)) {
+		t.Error("requests after cooldown must be allowed")
+	}
+	if rl.allow("15551234567", now.Add(6*time.Minute)) {
+		t.Error("request over the limit must be rejected")
+	}
+	if !rl.allow("15551234567", now.Add(61*time.Minute)) {
+		t.Error("request must be allowed once the window has passed")
+	}
+}
+
+func TestGenerateCode(t *testing.T) {
+	for _, length := range []int{4, 6, 10} {
+		code, err := generateCode(length)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(code) != length {
+			t.Errorf("expected %d digits, got '%s'", length, code)
+		}
+		for _, c := range code {
+			if c < '0' || c > '9' {
+				t.Errorf("non-digit in code '%s'", code)
+			}
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package tel
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+// Timeout of requests to SMS provider APIs.
+const smsRequestTimeout = 10 * time.Second
+
+// smsProvider sends text messages to phone numbers.
+type smsProvider interface {
+	// Send sends the text to the phone number.
+	Send(to, text string) error
+}
+
+// providerConfig selects an SMS provider and holds its configuration.
+type providerConfig struct {
+	// Provider name: "http", "twilio" or "file".
+	Name string `json:"name"`
+	// Configuration passed to the provider unchanged.
+	Config json.RawMessage `json:"config"`
+}
+
+// newProvider creates and initializes the SMS provider from config.
+func newProvider(conf *providerConfig) (smsProvider, error) {
+	if conf == nil || conf.Name == "" {
+		return nil, errors.New("tel: SMS provider is not configured")
+	}
+
+	var prov interface {
+		smsProvider
+		init(jsonconf []byte) error
+	}
+	switch conf.Name {
+	case "http":
+		prov = &httpProvider{}
+	case "twilio":
+		prov = &twilioProvider{}
+	case "file":
+		prov = &fileProvider{}
+	default:
+		return nil, errors.New("tel: unknown SMS provider '" + conf.Name + "'")
+	}
+
+	if err := prov.init(conf.Config); err != nil {
+		return nil, err
+	}
+	return prov, nil
+}
+
+// smsClient is used by providers for all HTTP requests.
+var smsClient = &http.Client{Timeout: smsRequestTimeout}
+
+// httpProvider sends SMS through an arbitrary HTTP API. The request URL and body are text/template
+// templates with {{.To}} and {{.Text}} fields, e.g. "https://sms.example.com/send?to={{.To | urlquery}}&msg={{.Text | urlquery}}".
+type httpProvider struct {
+	// HTTP method, GET by default.
+	Method string `json:"method"`
+	// Template of the request URL.
+	URL string `json:"url"`
+	// Optional template of the request body.
+	Body string `json:"body"`
+	// Additional request headers, e.g. "Authorization" or "Content-Type".
+	Headers map[string]string `json:"headers"`
+
+	urlTempl  *template.Template
+	bodyTempl *template.Template
+}
+
+func (p *httpProvider) init(jsonconf []byte) error {
+	if err := json.Unmarshal(jsonconf, p); err != nil {
+		return errors.New("tel: failed to parse http provider config: " + err.Error())
+	}
+	if p.URL == "" {
+		return errors.New("tel: http provider requires 'url'")
+	}
+	if p.Method == "" {
+		p.Method = http.MethodGet
+	}
+
+	var err error
+	if p.urlTempl, err = template.New("url").Parse(p.URL); err != nil {
+		return err
+	}
+	if p.Body != "" {
+		if p.bodyTempl, err = template.New("body").Parse(p.Body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *httpProvider) Send(to, text string) error {
+	params := map[string]string{"To": to, "Text": text}
+
+	reqURL := new(bytes.Buffer)
+	if err := p.urlTempl.Execute(reqURL, params); err != nil {
+		return err
+	}
+	var body io.Reader
+	if p.bodyTempl != nil {
+		buf := new(bytes.Buffer)
+		if err := p.bodyTempl.Execute(buf, params); err != nil {
+			return err
+		}
+		body = buf
+	}
+
+	req, err := http.NewRequest(p.Method, reqURL.String(), body)
+	if err != nil {
+		return err
+	}
+	for key, val := range p.Headers {
+		req.Header.Set(key, val)
+	}
+
+	return doRequest(req)
+}
+
+// twilioProvider sends SMS through the Twilio Messages API or a compatible service.
+type twilioProvider struct {
+	AccountSid string `json:"account_sid"`
+	AuthToken  string `json:"auth_token"`
+	// Sender phone number or messaging service SID.
+	From string `json:"from"`
+	// API base URL, "https://api.twilio.com" by default.
+	APIURL string `json:"api_url"`
+}
+
+func (p *twilioProvider) init(jsonconf []byte) error {
+	if err := json.Unmarshal(jsonconf, p); err != nil {
+		return errors.New("tel: failed to parse twilio provider config: " + err.Error())
+	}
+	if p.AccountSid == "" || p.AuthToken == "" || p.From == "" {
+		return errors.New("tel: twilio provider requires 'account_sid', 'auth_token' and 'from'")
+	}
+	if p.APIURL == "" {
+		p.APIURL = "https://api.twilio.com"
+	}
+	p.APIURL = strings.TrimSuffix(p.APIURL, "/")
+	return nil
+}
+
+func (p *twilioProvider) Send(to, text string) error {
+	form := url.Values{}
+	form.Set("To", to)
+	form.Set("From", p.From)
+	form.Set("Body", text)
+
+	req, err := http.NewRequest(http.MethodPost,
+		p.APIURL+"/2010-04-01/Accounts/"+url.PathEscape(p.AccountSid)+"/Messages.json",
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(p.AccountSid, p.AuthToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return doRequest(req)
+}
+
+// fileProvider does not send anything but appends messages to a file. Use it for testing.
+type fileProvider struct {
+	Path string `json:"path"`
+
+	lock sync.Mutex
+}
+
+func (p *fileProvider) init(jsonconf []byte) error {
+	if err := json.Unmarshal(jsonconf, p); err != nil {
+		return errors.New("tel: failed to parse file provider config: " + err.Error())
+	}
+	if p.Path == "" {
+		return errors.New("tel: file provider requires 'path'")
+	}
+	return nil
+}
+
+func (p *fileProvider) Send(to, text string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	f, err := os.OpenFile(p.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintf(f, "%s\t%s\t%s\n", time.Now().UTC().Format(time.RFC3339), to, text)
+	return err
+}
+
+// doRequest executes the HTTP request and checks that the response status is 2XX.
+func doRequest(req *http.Request) error {
+	resp, err := smsClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("tel: SMS provider responded %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package tel
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestHttpProvider(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL.Query().Get("to") + "|" + r.URL.Query().Get("msg")
+		if r.URL.Query().Get("to") == "fail" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	prov, err := newProvider(&providerConfig{
+		Name:   "http",
+		Config: []byte(`{"url": "` + srv.URL + `/send?to={{.To | urlquery}}&msg={{.Text | urlquery}}"}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := prov.Send("15551234567", "code: 123 456"); err != nil {
+		t.Fatal(err)
+	}
+	if got != "15551234567|code: 123 456" {
+		t.Error("unexpected request", got)
+	}
+
+	if err := prov.Send("fail", "text"); err == nil {
+		t.Error("expected error on HTTP 400")
+	}
+}
+
+func TestFileProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "sms.log")
+
+	prov, err := newProvider(&providerConfig{Name: "file", Config: []byte(`{"path": "` + path + `"}`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := prov.Send("15551234567", "code: 123456"); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(string(data), "\t15551234567\tcode: 123456\n") {
+		t.Error("unexpected file content", string(data))
+	}
+}
+
+func TestUnknownProvider(t *testing.T) {
+	if _, err := newProvider(&providerConfig{Name: "carrier-pigeon"}); err == nil {
+		t.Error("expected error for unknown provider")
+	}
+}
@@ -1,36 +1,147 @@
+// Package tel is a validator of phone numbers which sends confirmation codes by SMS
+// through a configurable provider.
 package tel
 
 import (
-	"fmt"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"text/template"
+	"time"
+
 	"github.com/tinode/chat/server/store"
 	t "github.com/tinode/chat/server/store/types"
-	"io/ioutil"
-	"log"
-	"math/rand"
-	"net/http"
-	"strconv"
-	"strings"
 )
 
 const (
 	maxTellLength = 11
 
-	codeLength   = 6
-	maxCodeValue = 1000000
-
 	maxRetries = 4
 
-	smsUrl = "http://c.realsms.cn:38811/api/v10/send?usr=saibin&pwd=q1w2e3r9&src=137&dest=%s&msg=%s"
+	// Default length of the code and the allowed range.
+	defaultCodeLength = 6
+	minCodeLength     = 4
+	maxCodeLength     = 10
+
+	// Default lifetime of the code.
+	defaultCodeTTL = 10 * time.Minute
+
+	// Default minimum time between two SMS to the same number.
+	defaultSendCooldown = time.Minute
+	// Default maximum number of SMS per hour to the same number and from the same IP address.
+	defaultMaxSendsPerCred = 5
+	defaultMaxSendsPerIP   = 20
 )
 
-// Empty placeholder struct.
-type validator struct{}
+// Validator configuration.
+type validator struct {
+	// Template of the SMS with the validation code. Uses text/template syntax.
+	ValidationTemplFile string `json:"template"`
+	// Template of the SMS with the code for resetting the secret.
+	ResetTemplFile string `json:"reset_template"`
+	// Optional template of the SMS informing the user that the secret was reset.
+	NoticeTemplFile string `json:"reset_notice_template"`
+	MaxRetries      int    `json:"max_retries"`
+	// Number of digits in the code.
+	CodeLength int `json:"code_length"`
+	// Lifetime of the code in seconds.
+	CodeTTL int `json:"code_ttl"`
+	// Minimum number of seconds between two SMS to the same number.
+	SendCooldown int `json:"send_cooldown"`
+	// Maximum number of SMS per hour to the same number.
+	MaxSendsPerCred int `json:"max_sends_per_cred"`
+	// Maximum number of SMS per hour requested from the same IP address.
+	MaxSendsPerIP int `json:"max_sends_per_ip"`
+	// Dummy response accepted for any number. For testing only, honored if AllowDebugResponse is true.
+	DebugResponse      string `json:"debug_response"`
+	AllowDebugResponse bool   `json:"allow_debug_response"`
+	// SMS provider to use.
+	Provider *providerConfig `json:"provider"`
+
+	validationTempl *template.Template
+	resetTempl      *template.Template
+	noticeTempl     *template.Template
+	provider        smsProvider
+	codeTTL         time.Duration
+	credLimiter     *rateLimiter
+	ipLimiter       *rateLimiter
+}
+
+// Init initializes the validator: loads message templates and initializes the SMS provider.
+func (v *validator) Init(jsonconf string) error {
+	var err error
+	if err = json.Unmarshal([]byte(jsonconf), v); err != nil {
+		return err
+	}
+
+	if v.validationTempl, err = parseTemplate(v.ValidationTemplFile); err != nil {
+		return err
+	}
+	if v.ResetTemplFile == "" {
+		// Use the same text for validation and reset.
+		v.resetTempl = v.validationTempl
+	} else if v.resetTempl, err = parseTemplate(v.ResetTemplFile); err != nil {
+		return err
+	}
+
+	if v.NoticeTemplFile != "" {
+		if v.noticeTempl, err = parseTemplate(v.NoticeTemplFile); err != nil {
+			return err
+		}
+	}
+
+	if v.provider, err = newProvider(v.Provider); err != nil {
+		return err
+	}
+
+	if v.MaxRetries == 0 {
+		v.MaxRetries = maxRetries
+	}
+	if v.CodeLength == 0 {
+		v.CodeLength = defaultCodeLength
+	} else if v.CodeLength < minCodeLength || v.CodeLength > maxCodeLength {
+		return errors.New("tel: code_length must be between 4 and 10")
+	}
+	v.codeTTL = defaultCodeTTL
+	if v.CodeTTL > 0 {
+		v.codeTTL = time.Duration(v.CodeTTL) * time.Second
+	}
+	cooldown := defaultSendCooldown
+	if v.SendCooldown > 0 {
+		cooldown = time.Duration(v.SendCooldown) * time.Second
+	}
+	if v.MaxSendsPerCred == 0 {
+		v.MaxSendsPerCred = defaultMaxSendsPerCred
+	}
+	if v.MaxSendsPerIP == 0 {
+		v.MaxSendsPerIP = defaultMaxSendsPerIP
+	}
+	v.credLimiter = newRateLimiter(cooldown, v.MaxSendsPerCred, time.Hour)
+	v.ipLimiter = newRateLimiter(0, v.MaxSendsPerIP, time.Hour)
+
+	if v.AllowDebugResponse && v.DebugResponse != "" {
+		log.Println("tel: debug response is enabled, do not use in production")
+	}
 
-// Init is a noop.
-func (*validator) Init(jsonconf string) error {
 	return nil
 }
 
+// parseTemplate loads the template from file. A relative path is resolved relative
+// to the exec file location, not whatever directory the user is in.
+func parseTemplate(path string) (*template.Template, error) {
+	if !filepath.IsAbs(path) {
+		basepath, err := os.Executable()
+		if err == nil {
+			path = filepath.Join(filepath.Dir(basepath), path)
+		}
+	}
+	return template.ParseFiles(path)
+}
+
 // PreCheck validates the credential and parameters without sending an SMS or making the call.
 func (*validator) PreCheck(cred string, params interface{}) error {
 	if len(cred) != maxTellLength {
@@ -44,79 +155,104 @@ func (*validator) PreCheck(cred string, params interface{}) error {
 	return nil
 }
 
-// Request sends a request for confirmation to the user: makes a record in DB  and nothing else.
-func (v *validator) Request(user t.Uid, cred, lang, resp string, tmpToken []byte) error {
+// Request saves the confirmation code in DB and sends it to the user in an SMS. Repeated requests
+// replace the code of the pending credential.
+func (v *validator) Request(user t.Uid, cred, lang, remoteAddr, resp string, tmpToken []byte) error {
 	if resp != "" {
 		return t.ErrFailed
 	}
 
-	resp = strconv.FormatInt(int64(rand.Intn(maxCodeValue)), 10)
-	resp = strings.Repeat("0", codeLength-len(resp)) + resp
+	if !v.allowSend(cred, remoteAddr) {
+		return t.ErrPolicy
+	}
 
-	if e := v.send(cred, resp); e != nil {
-		return e
+	resp, err := generateCode(v.CodeLength)
+	if err != nil {
+		return err
 	}
 
-	return store.Users.SaveCred(&t.Credential{
+	if err := store.Users.UpsertCred(&t.Credential{
 		User:   user.String(),
 		Method: "tel",
 		Value:  cred,
 		Resp:   resp,
-	})
+	}); err != nil {
+		return err
+	}
+
+	return v.send(cred, v.validationTempl, resp)
 }
 
-func (v *validator) send(to, body string) error {
-	url := fmt.Sprintf(smsUrl, to, body)
-	resp, err := http.Get(url)
+// allowSend checks the rate limits of sending SMS to the number and from the IP address.
+func (v *validator) allowSend(cred, remoteAddr string) bool {
+	now := time.Now()
+	if ip, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		remoteAddr = ip
+	}
+	if remoteAddr != "" && !v.ipLimiter.allow(remoteAddr, now) {
+		log.Println("tel: too many requests from", remoteAddr)
+		return false
+	}
+	if !v.credLimiter.allow(cred, now) {
+		log.Println("tel: too many requests to", cred)
+		return false
+	}
+	return true
+}
 
-	if err != nil {
-		log.Println("send sms ", err)
+// send renders the message with the code and sends it to the phone number.
+func (v *validator) send(to string, templ *template.Template, code string) error {
+	body := new(bytes.Buffer)
+	if err := templ.Execute(body, map[string]interface{}{"Code": code}); err != nil {
 		return err
 	}
-	defer resp.Body.Close()
-	_, e := ioutil.ReadAll(resp.Body)
-	if e != nil {
-		log.Println("read sms msg error: ", err)
-		return e
-	}
 
-	return err
+	if err := v.provider.Send(to, body.String()); err != nil {
+		log.Println("tel: failed to send SMS", to, err)
+		return err
+	}
+	return nil
 }
 
 // ResetSecret sends a message with instructions for resetting an authentication secret.
-func (v *validator) ResetSecret(cred, scheme, lang string, tmpToken []byte) error {
-	resp := strconv.FormatInt(int64(rand.Intn(maxCodeValue)), 10)
-	resp = strings.Repeat("0", codeLength-len(resp)) + resp
+func (v *validator) ResetSecret(cred, scheme, lang, remoteAddr string, tmpToken []byte) error {
+	if !v.allowSend(cred, remoteAddr) {
+		return t.ErrPolicy
+	}
 
-	if err := v.send(cred, resp); err != nil {
+	forgot, err := store.Forgot.GetByCred("tel", cred)
+	if err != nil {
 		return err
 	}
 
-	uid, err := store.Users.GetByCred("tel", cred)
+	resp, err := generateCode(v.CodeLength)
 	if err != nil {
 		return err
 	}
 
-	err = store.Users.DelCred(uid, "tel")
-
-	if err := store.Forgot.SaveForgot(&t.Forgot{
-		Token: tmpToken,
-		Tel:   cred,
-		Done:  false,
-	}); err != nil {
+	// The code is kept with the recovery request, the confirmed credential is not changed.
+	if err := store.Forgot.SetCode(forgot.Id, resp); err != nil {
 		return err
 	}
 
-	return store.Users.SaveCred(&t.Credential{
-		User:   uid.String(),
-		Method: "tel",
-		Value:  cred,
-		Resp:   resp,
-	})
+	return v.send(cred, v.resetTempl, resp)
+}
+
+// NotifySecretReset sends an SMS informing the user that the authentication secret was reset.
+func (v *validator) NotifySecretReset(cred, scheme, lang string) error {
+	if v.noticeTempl == nil {
+		return nil
+	}
+
+	body := new(bytes.Buffer)
+	if err := v.noticeTempl.Execute(body, map[string]interface{}{"Scheme": scheme}); err != nil {
+		return err
+	}
+	return v.provider.Send(cred, body.String())
 }
 
 // Check checks validity of user's response.
-func (*validator) Check(user t.Uid, resp string) (string, error) {
+func (v *validator) Check(user t.Uid, resp string) (string, error) {
 	cred, err := store.Users.GetCred(user, "tel")
 
 	if err != nil {
@@ -124,17 +260,27 @@ func (*validator) Check(user t.Uid, resp string) (string, error) {
 		return "", err
 	}
 
-	if cred.Retries > maxRetries {
+	if cred == nil {
+		// Request to validate non-existent credential.
+		return "", t.ErrNotFound
+	}
+
+	// Locked out after too many failed attempts. A new code must be requested.
+	if cred.Retries >= v.MaxRetries {
 		return "", t.ErrPolicy
 	}
 
+	if time.Since(cred.CreatedAt) > v.codeTTL {
+		return "", t.ErrExpired
+	}
+
 	if resp == "" {
 		return "", t.ErrCredentials
 	}
 
-	if cred.Resp == resp || resp == "666666" {
+	// Dummy response is accepted only if explicitly enabled.
+	if cred.Resp == resp || (v.AllowDebugResponse && v.DebugResponse != "" && v.DebugResponse == resp) {
 		err = store.Users.ConfirmCred(user, "tel")
-		log.Print("check success ", err)
 		return cred.Value, err
 	}
 
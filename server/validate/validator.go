@@ -18,15 +18,23 @@ type Validator interface {
 	// 	user: UID of the user making the request.
 	// 	cred: credential being validated, such as email or phone.
 	//  lang: user's human language as repored in the session.
+	//  remoteAddr: IP address of the client making the request.
 	//  resp: optional response if user already has it (i.e. captcha/recaptcha).
-	Request(user t.Uid, cred, lang, resp string, tmpToken []byte) error
+	Request(user t.Uid, cred, lang, remoteAddr, resp string, tmpToken []byte) error
 
 	// ResetSecret sends a message with instructions for resetting an authentication secret.
 	//  cred: address to use for the message.
 	//  scheme: authentication scheme being reset.
 	//  lang: human language as reported in the session.
+	//  remoteAddr: IP address of the client making the request.
 	//  tmpToken: temporary authentication token
-	ResetSecret(cred, scheme, lang string, tmpToken []byte) error
+	ResetSecret(cred, scheme, lang, remoteAddr string, tmpToken []byte) error
+
+	// NotifySecretReset informs the user that the authentication secret was reset.
+	//  cred: address to use for the message.
+	//  scheme: authentication scheme which was reset.
+	//  lang: human language as reported in the session.
+	NotifySecretReset(cred, scheme, lang string) error
 
 	// Check checks validity of user's response.
 	// Returns the value of validated credential on success.